@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"cf"
 	"cf/configuration"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -19,7 +20,8 @@ type ApplicationRepository interface {
 	SetEnv(app cf.Application, name string, value string) (err error)
 	Create(newApp cf.Application) (createdApp cf.Application, err error)
 	Delete(app cf.Application) (err error)
-	Upload(app cf.Application, zipBuffer *bytes.Buffer) (err error)
+	Rename(app cf.Application, newName string) (err error)
+	Upload(app cf.Application, zipBuffer *bytes.Buffer, progress ProgressReporter) (err error)
 	Start(app cf.Application) (err error)
 	Stop(app cf.Application) (err error)
 	GetInstances(app cf.Application) (instances []cf.ApplicationInstance, errorCode int, err error)
@@ -151,7 +153,28 @@ func (repo CloudControllerApplicationRepository) Delete(app cf.Application) (err
 	return
 }
 
-func (repo CloudControllerApplicationRepository) Upload(app cf.Application, zipBuffer *bytes.Buffer) (err error) {
+func (repo CloudControllerApplicationRepository) Rename(app cf.Application, newName string) (err error) {
+	err = validateApplication(cf.Application{Name: newName})
+	if err != nil {
+		return
+	}
+
+	path := fmt.Sprintf("%s/v2/apps/%s", repo.config.Target, app.Guid)
+	data, err := json.Marshal(map[string]string{"name": newName})
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("PUT", path, repo.config.AccessToken, bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func (repo CloudControllerApplicationRepository) Upload(app cf.Application, zipBuffer *bytes.Buffer, progress ProgressReporter) (err error) {
 	url := fmt.Sprintf("%s/v2/apps/%s/bits", repo.config.Target, app.Guid)
 
 	body, boundary, err := createApplicationUploadBody(zipBuffer)
@@ -159,12 +182,14 @@ func (repo CloudControllerApplicationRepository) Upload(app cf.Application, zipB
 		return
 	}
 
-	request, err := NewRequest("PUT", url, repo.config.AccessToken, body)
-	contentType := fmt.Sprintf("multipart/form-data; boundary=%s", boundary)
-	request.Header.Set("Content-Type", contentType)
+	bodySize := int64(body.Len())
+	request, err := NewRequest("PUT", url, repo.config.AccessToken, NewProgressReader(body, bodySize, progress))
 	if err != nil {
 		return
 	}
+	contentType := fmt.Sprintf("multipart/form-data; boundary=%s", boundary)
+	request.Header.Set("Content-Type", contentType)
+	request.ContentLength = bodySize
 
 	_, err = repo.apiClient.PerformRequest(request)
 	return
@@ -223,9 +248,14 @@ func (repo CloudControllerApplicationRepository) changeApplicationState(app cf.A
 }
 
 func validateApplication(app cf.Application) (err error) {
+	return validateName("Application", app.Name)
+}
+
+// Apps and service instances follow the same naming rules.
+func validateName(kind string, name string) (err error) {
 	reg := regexp.MustCompile("^[0-9a-zA-Z\\-_]*$")
-	if !reg.MatchString(app.Name) {
-		err = errors.New("Application name is invalid. Name can only contain letters, numbers, underscores and hyphens.")
+	if !reg.MatchString(name) {
+		err = errors.New(fmt.Sprintf("%s name is invalid. Name can only contain letters, numbers, underscores and hyphens.", kind))
 	}
 
 	return
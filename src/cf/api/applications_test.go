@@ -296,6 +296,39 @@ func TestDeleteApplication(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+var renameApplicationEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/apps/my-app-guid",
+	testhelpers.RequestBodyMatcher(`{"name":"my-new-app"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestRenameApplication(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(renameApplicationEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerApplicationRepository(config, client)
+
+	app := cf.Application{Name: "my-app", Guid: "my-app-guid"}
+	err := repo.Rename(app, "my-new-app")
+	assert.NoError(t, err)
+}
+
+func TestRenameRejectsInproperNames(t *testing.T) {
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token"}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerApplicationRepository(config, client)
+
+	app := cf.Application{Name: "my-app", Guid: "my-app-guid"}
+	err := repo.Rename(app, "name with space")
+	assert.Contains(t, err.Error(), "Application name is invalid")
+}
+
 var uploadBodyMatcher = func(request *http.Request) bool {
 	bodyBytes, err := ioutil.ReadAll(request.Body)
 
@@ -341,8 +374,16 @@ func TestUploadApplication(t *testing.T) {
 	app := cf.Application{Name: "my-cool-app", Guid: "my-cool-app-guid"}
 	zipBuffer := bytes.NewBufferString("hello world!")
 
-	err := repo.Upload(app, zipBuffer)
+	var reportedSent, reportedTotal int64
+	progress := func(sent int64, total int64, bytesPerSecond int64) {
+		reportedSent = sent
+		reportedTotal = total
+	}
+
+	err := repo.Upload(app, zipBuffer, progress)
 	assert.NoError(t, err)
+	assert.True(t, reportedTotal > int64(len("hello world!")))
+	assert.Equal(t, reportedSent, reportedTotal)
 }
 
 var startApplicationEndpoint = testhelpers.CreateEndpoint(
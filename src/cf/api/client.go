@@ -146,6 +146,8 @@ func Sanitize(input string) (sanitized string) {
 	sanitized = re.ReplaceAllString(sanitized, `"access_token":"`+PRIVATE_DATA_PLACEHOLDER+`"`)
 	re = regexp.MustCompile(`"refresh_token":"[^"]*"`)
 	sanitized = re.ReplaceAllString(sanitized, `"refresh_token":"`+PRIVATE_DATA_PLACEHOLDER+`"`)
+	re = regexp.MustCompile(`"auth_password":"(?:[^"\\]|\\.)*"`)
+	sanitized = re.ReplaceAllString(sanitized, `"auth_password":"`+PRIVATE_DATA_PLACEHOLDER+`"`)
 	return
 }
 
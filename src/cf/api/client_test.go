@@ -213,3 +213,24 @@ func TestRefreshingTheToken(t *testing.T) {
 	assert.Equal(t, savedConfig.AccessToken, "bearer new-access-token")
 	assert.Equal(t, savedConfig.RefreshToken, "new-refresh-token")
 }
+
+func TestSanitizeRemovesServiceBrokerPassword(t *testing.T) {
+	request := `
+POST /v2/service_brokers HTTP/1.1
+Host: api.run.pivotal.io
+Authorization: [PRIVATE DATA HIDDEN]
+Content-Type: application/json
+
+{"name":"my-broker","broker_url":"http://broker.example.com","auth_username":"admin","auth_password":"my \"secret\" password"}
+`
+
+	expected := `
+POST /v2/service_brokers HTTP/1.1
+Host: api.run.pivotal.io
+Authorization: [PRIVATE DATA HIDDEN]
+Content-Type: application/json
+
+{"name":"my-broker","broker_url":"http://broker.example.com","auth_username":"admin","auth_password":"[PRIVATE DATA HIDDEN]"}
+`
+	assert.Equal(t, Sanitize(request), expected)
+}
@@ -10,7 +10,14 @@ import (
 
 type DomainRepository interface {
 	FindAll() (domains []cf.Domain, err error)
+	FindAllInSpace(space cf.Space) (domains []cf.Domain, err error)
+	FindAllInCurrentOrg() (domains []cf.Domain, err error)
 	FindByName(name string) (domain cf.Domain, err error)
+	FindByNameInCurrentOrg(name string) (domain cf.Domain, err error)
+	Create(domainToCreate cf.Domain, owningOrg cf.Organization) (createdDomain cf.Domain, err error)
+	MapDomain(domain cf.Domain, space cf.Space) (err error)
+	UnmapDomain(domain cf.Domain, space cf.Space) (err error)
+	Delete(domain cf.Domain) (err error)
 }
 
 type CloudControllerDomainRepository struct {
@@ -25,44 +32,173 @@ func NewCloudControllerDomainRepository(config *configuration.Configuration, api
 }
 
 func (repo CloudControllerDomainRepository) FindAll() (domains []cf.Domain, err error) {
-	path := fmt.Sprintf("%s/v2/spaces/%s/domains", repo.config.Target, repo.config.Space.Guid)
+	return repo.FindAllInSpace(repo.config.Space)
+}
+
+func (repo CloudControllerDomainRepository) FindAllInSpace(space cf.Space) (domains []cf.Domain, err error) {
+	path := fmt.Sprintf("%s/v2/spaces/%s/domains", repo.config.Target, space.Guid)
+	return repo.findDomains(path)
+}
+
+func (repo CloudControllerDomainRepository) FindAllInCurrentOrg() (domains []cf.Domain, err error) {
+	path := fmt.Sprintf("%s/v2/organizations/%s/domains?inline-relations-depth=1", repo.config.Target, repo.config.Organization.Guid)
+	return repo.findDomains(path)
+}
+
+func (repo CloudControllerDomainRepository) findDomains(path string) (domains []cf.Domain, err error) {
 	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
 	if err != nil {
 		return
 	}
 
-	response := new(ApiResponse)
+	response := new(DomainApiResponse)
 	_, err = repo.apiClient.PerformRequestAndParseResponse(request, response)
 	if err != nil {
 		return
 	}
 
 	for _, r := range response.Resources {
-		domains = append(domains, cf.Domain{r.Entity.Name, r.Metadata.Guid})
+		domains = append(domains, newDomainFromResource(r))
 	}
 
 	return
 }
 
+func newDomainFromResource(r DomainResource) (domain cf.Domain) {
+	domain.Name = r.Entity.Name
+	domain.Guid = r.Metadata.Guid
+	domain.Shared = r.Entity.OwningOrganizationGuid == ""
+	domain.OwningOrganization = cf.Organization{
+		Name: r.Entity.OwningOrganization.Entity.Name,
+		Guid: r.Entity.OwningOrganizationGuid,
+	}
+	return
+}
+
 func (repo CloudControllerDomainRepository) FindByName(name string) (domain cf.Domain, err error) {
 	domains, err := repo.FindAll()
-
 	if err != nil {
 		return
 	}
 
 	if name == "" {
+		return repo.defaultDomain(domains)
+	}
+
+	return findDomainByName(domains, name)
+}
+
+func (repo CloudControllerDomainRepository) FindByNameInCurrentOrg(name string) (domain cf.Domain, err error) {
+	domains, err := repo.FindAllInCurrentOrg()
+	if err != nil {
+		return
+	}
+
+	return findDomainByName(domains, name)
+}
+
+func findDomainByName(domains []cf.Domain, name string) (domain cf.Domain, err error) {
+	for _, d := range domains {
+		if d.Name == strings.ToLower(name) {
+			return d, nil
+		}
+	}
+
+	err = errors.New(fmt.Sprintf("Could not find domain with name %s", name))
+	return
+}
+
+// The domain used when none is given is, in order: the default saved for
+// the targeted space, the only domain of the space, or its only shared domain.
+func (repo CloudControllerDomainRepository) defaultDomain(domains []cf.Domain) (domain cf.Domain, err error) {
+	space := repo.config.Space
+
+	defaultName := repo.config.DefaultDomainForSpace(space)
+	if defaultName != "" {
+		domain, err = findDomainByName(domains, defaultName)
+		if err != nil {
+			err = errors.New(fmt.Sprintf("Default domain %s is not mapped to space %s", defaultName, space.Name))
+		}
+		return
+	}
+
+	if len(domains) == 0 {
+		err = errors.New(fmt.Sprintf("No domains found for space %s", space.Name))
+		return
+	}
+
+	if len(domains) == 1 {
 		domain = domains[0]
-	} else {
-		err = errors.New(fmt.Sprintf("Could not find domain with name %s", name))
-
-		for _, d := range domains {
-			if d.Name == strings.ToLower(name) {
-				domain = d
-				err = nil
-			}
+		return
+	}
+
+	sharedDomains := []cf.Domain{}
+	for _, d := range domains {
+		if d.Shared {
+			sharedDomains = append(sharedDomains, d)
 		}
 	}
 
+	if len(sharedDomains) == 1 {
+		domain = sharedDomains[0]
+		return
+	}
+
+	err = errors.New(fmt.Sprintf("Space %s has several domains. Specify one, or set a default with 'cf set-default-domain'.", space.Name))
+	return
+}
+
+func (repo CloudControllerDomainRepository) Create(domainToCreate cf.Domain, owningOrg cf.Organization) (createdDomain cf.Domain, err error) {
+	path := fmt.Sprintf("%s/v2/domains?inline-relations-depth=1", repo.config.Target)
+	data := fmt.Sprintf(
+		`{"name":"%s","wildcard":true,"owning_organization_guid":"%s"}`,
+		domainToCreate.Name, owningOrg.Guid,
+	)
+	request, err := NewRequest("POST", path, repo.config.AccessToken, strings.NewReader(data))
+	if err != nil {
+		return
+	}
+
+	resource := new(DomainResource)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, resource)
+	if err != nil {
+		return
+	}
+
+	createdDomain = newDomainFromResource(*resource)
+	createdDomain.OwningOrganization = owningOrg
+	return
+}
+
+func (repo CloudControllerDomainRepository) MapDomain(domain cf.Domain, space cf.Space) (err error) {
+	path := fmt.Sprintf("%s/v2/spaces/%s/domains/%s", repo.config.Target, space.Guid, domain.Guid)
+	request, err := NewRequest("PUT", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func (repo CloudControllerDomainRepository) UnmapDomain(domain cf.Domain, space cf.Space) (err error) {
+	path := fmt.Sprintf("%s/v2/spaces/%s/domains/%s", repo.config.Target, space.Guid, domain.Guid)
+	request, err := NewRequest("DELETE", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func (repo CloudControllerDomainRepository) Delete(domain cf.Domain) (err error) {
+	path := fmt.Sprintf("%s/v2/domains/%s", repo.config.Target, domain.Guid)
+	request, err := NewRequest("DELETE", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
 	return
 }
@@ -31,7 +31,8 @@ var multipleDomainsResponse = testhelpers.TestResponse{Status: http.StatusOK, Bo
         "guid": "domain2-guid"
       },
       "entity": {
-        "name": "domain2.cf-app.com"
+        "name": "domain2.cf-app.com",
+        "owning_organization_guid": "my-org-guid"
       }
     }
   ]
@@ -87,7 +88,7 @@ func TestFindByNameReturnsTheDomainMatchingTheName(t *testing.T) {
 	assert.Equal(t, domain.Guid, "domain2-guid")
 }
 
-func TestFindByNameReturnsTheFirstDomainIfNameEmpty(t *testing.T) {
+func TestFindByNameWithoutNameUsesTheDefaultDomainOfTheSpace(t *testing.T) {
 	ts := httptest.NewTLSServer(http.HandlerFunc(multipleDomainsEndpoint))
 	defer ts.Close()
 
@@ -96,12 +97,257 @@ func TestFindByNameReturnsTheFirstDomainIfNameEmpty(t *testing.T) {
 		Target:      ts.URL,
 		Space:       cf.Space{Guid: "my-space-guid"},
 	}
+	config.SetDefaultDomainForSpace(config.Space, "domain2.cf-app.com")
 	client := NewApiClient(&testhelpers.FakeAuthenticator{})
 	repo := NewCloudControllerDomainRepository(config, client)
 
 	domain, err := repo.FindByName("")
 	assert.NoError(t, err)
+	assert.Equal(t, domain.Name, "domain2.cf-app.com")
+	assert.Equal(t, domain.Guid, "domain2-guid")
+}
+
+func TestFindByNameWithoutNameFailsWhenTheDefaultDomainIsNotMapped(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(multipleDomainsEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+		Space:       cf.Space{Name: "my-space", Guid: "my-space-guid"},
+	}
+	config.SetDefaultDomainForSpace(config.Space, "gone.cf-app.com")
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerDomainRepository(config, client)
+
+	_, err := repo.FindByName("")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "gone.cf-app.com")
+}
+
+func TestFindByNameWithoutNameFallsBackToTheOnlySharedDomain(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(multipleDomainsEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+		Space:       cf.Space{Guid: "my-space-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerDomainRepository(config, client)
 
+	domain, err := repo.FindByName("")
+	assert.NoError(t, err)
 	assert.Equal(t, domain.Name, "domain1.cf-app.com")
-	assert.Equal(t, domain.Guid, "domain1-guid")
+	assert.True(t, domain.Shared)
+}
+
+var ambiguousDomainsEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/spaces/my-space-guid/domains",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    {
+      "metadata": { "guid": "domain1-guid" },
+      "entity": { "name": "domain1.cf-app.com" }
+    },
+    {
+      "metadata": { "guid": "domain2-guid" },
+      "entity": { "name": "domain2.cf-app.com" }
+    }
+  ]
+}`},
+)
+
+func TestFindByNameWithoutNameFailsWhenTheDomainIsAmbiguous(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(ambiguousDomainsEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+		Space:       cf.Space{Name: "my-space", Guid: "my-space-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerDomainRepository(config, client)
+
+	_, err := repo.FindByName("")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "set-default-domain")
+}
+
+var orgDomainsEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/organizations/my-org-guid/domains?inline-relations-depth=1",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    {
+      "metadata": { "guid": "shared-domain-guid" },
+      "entity": {
+        "name": "cf-app.com",
+        "owning_organization_guid": null
+      }
+    },
+    {
+      "metadata": { "guid": "private-domain-guid" },
+      "entity": {
+        "name": "example.com",
+        "owning_organization_guid": "my-org-guid",
+        "owning_organization": {
+          "metadata": { "guid": "my-org-guid" },
+          "entity": { "name": "my-org" }
+        }
+      }
+    }
+  ]
+}`},
+)
+
+func TestFindAllInCurrentOrg(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(orgDomainsEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken:  "BEARER my_access_token",
+		Target:       ts.URL,
+		Organization: cf.Organization{Guid: "my-org-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerDomainRepository(config, client)
+
+	domains, err := repo.FindAllInCurrentOrg()
+	assert.NoError(t, err)
+	assert.Equal(t, len(domains), 2)
+
+	assert.Equal(t, domains[0].Name, "cf-app.com")
+	assert.True(t, domains[0].Shared)
+
+	assert.Equal(t, domains[1].Name, "example.com")
+	assert.Equal(t, domains[1].Guid, "private-domain-guid")
+	assert.False(t, domains[1].Shared)
+	assert.Equal(t, domains[1].OwningOrganization, cf.Organization{Name: "my-org", Guid: "my-org-guid"})
+}
+
+func TestFindByNameInCurrentOrg(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(orgDomainsEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken:  "BEARER my_access_token",
+		Target:       ts.URL,
+		Organization: cf.Organization{Guid: "my-org-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerDomainRepository(config, client)
+
+	domain, err := repo.FindByNameInCurrentOrg("Example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.Guid, "private-domain-guid")
+
+	_, err = repo.FindByNameInCurrentOrg("missing.com")
+	assert.Error(t, err)
+}
+
+var createDomainEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/domains",
+	testhelpers.RequestBodyMatcher(`{"name":"example.com","wildcard":true,"owning_organization_guid":"my-org-guid"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated, Body: `
+{
+    "metadata": { "guid": "example-domain-guid" },
+    "entity": {
+        "name": "example.com",
+        "owning_organization_guid": "my-org-guid"
+    }
+}`},
+)
+
+func TestCreateDomain(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(createDomainEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerDomainRepository(config, client)
+
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	createdDomain, err := repo.Create(cf.Domain{Name: "example.com"}, org)
+	assert.NoError(t, err)
+	assert.Equal(t, createdDomain.Guid, "example-domain-guid")
+	assert.Equal(t, createdDomain.OwningOrganization, org)
+	assert.False(t, createdDomain.Shared)
+}
+
+var mapDomainEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/spaces/my-space-guid/domains/my-domain-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusCreated, Body: "{}"},
+)
+
+func TestMapDomain(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(mapDomainEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerDomainRepository(config, client)
+
+	err := repo.MapDomain(cf.Domain{Guid: "my-domain-guid"}, cf.Space{Guid: "my-space-guid"})
+	assert.NoError(t, err)
+}
+
+var unmapDomainEndpoint = testhelpers.CreateEndpoint(
+	"DELETE",
+	"/v2/spaces/my-space-guid/domains/my-domain-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: "{}"},
+)
+
+func TestUnmapDomain(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(unmapDomainEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerDomainRepository(config, client)
+
+	err := repo.UnmapDomain(cf.Domain{Guid: "my-domain-guid"}, cf.Space{Guid: "my-space-guid"})
+	assert.NoError(t, err)
+}
+
+var deleteDomainEndpoint = testhelpers.CreateEndpoint(
+	"DELETE",
+	"/v2/domains/my-domain-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusNoContent, Body: ""},
+)
+
+func TestDeleteDomain(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(deleteDomainEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerDomainRepository(config, client)
+
+	err := repo.Delete(cf.Domain{Guid: "my-domain-guid"})
+	assert.NoError(t, err)
 }
@@ -1,15 +1,22 @@
 package api
 
 import (
+	"bytes"
 	"cf"
 	"cf/configuration"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 )
 
 type OrganizationRepository interface {
 	FindAll() (orgs []cf.Organization, err error)
 	FindByName(name string) (org cf.Organization, err error)
+	GetSummary(org cf.Organization) (orgWithSummary cf.Organization, err error)
+	Create(name string) (createdOrg cf.Organization, err error)
+	Rename(org cf.Organization, newName string) (err error)
+	Delete(org cf.Organization) (err error)
 }
 
 type CloudControllerOrganizationRepository struct {
@@ -38,7 +45,7 @@ func (repo CloudControllerOrganizationRepository) FindAll() (orgs []cf.Organizat
 	}
 
 	for _, r := range response.Resources {
-		orgs = append(orgs, cf.Organization{r.Entity.Name, r.Metadata.Guid})
+		orgs = append(orgs, cf.Organization{Name: r.Entity.Name, Guid: r.Metadata.Guid})
 	}
 
 	return
@@ -61,3 +68,95 @@ func (repo CloudControllerOrganizationRepository) FindByName(name string) (org c
 	err = errors.New("Organization not found")
 	return
 }
+
+func (repo CloudControllerOrganizationRepository) GetSummary(org cf.Organization) (orgWithSummary cf.Organization, err error) {
+	path := fmt.Sprintf("%s/v2/organizations/%s?inline-relations-depth=1", repo.config.Target, org.Guid)
+	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	orgResource := new(OrganizationResource)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, orgResource)
+	if err != nil {
+		return
+	}
+
+	path = fmt.Sprintf("%s/v2/organizations/%s/summary", repo.config.Target, org.Guid)
+	request, err = NewRequest("GET", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	summary := new(OrganizationSummary)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, summary)
+	if err != nil {
+		return
+	}
+
+	orgWithSummary.Name = orgResource.Entity.Name
+	orgWithSummary.Guid = orgResource.Metadata.Guid
+
+	orgWithSummary.QuotaDefinition = newQuotaFromResource(orgResource.Entity.QuotaDefinition)
+
+	for _, domain := range orgResource.Entity.Domains {
+		orgWithSummary.Domains = append(orgWithSummary.Domains, cf.Domain{Name: domain.Entity.Name, Guid: domain.Metadata.Guid})
+	}
+
+	for _, space := range summary.Spaces {
+		orgWithSummary.Spaces = append(orgWithSummary.Spaces, cf.Space{Name: space.Name, Guid: space.Guid})
+		orgWithSummary.AppCount += space.AppCount
+		orgWithSummary.ServiceCount += space.ServiceCount
+	}
+
+	return
+}
+
+func (repo CloudControllerOrganizationRepository) Create(name string) (createdOrg cf.Organization, err error) {
+	path := repo.config.Target + "/v2/organizations"
+	data, err := json.Marshal(map[string]string{"name": name})
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("POST", path, repo.config.AccessToken, bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+
+	resource := new(Resource)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, resource)
+	if err != nil {
+		return
+	}
+
+	createdOrg = cf.Organization{Name: resource.Entity.Name, Guid: resource.Metadata.Guid}
+	return
+}
+
+func (repo CloudControllerOrganizationRepository) Rename(org cf.Organization, newName string) (err error) {
+	path := fmt.Sprintf("%s/v2/organizations/%s", repo.config.Target, org.Guid)
+	data, err := json.Marshal(map[string]string{"name": newName})
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("PUT", path, repo.config.AccessToken, bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func (repo CloudControllerOrganizationRepository) Delete(org cf.Organization) (err error) {
+	path := fmt.Sprintf("%s/v2/organizations/%s?recursive=true", repo.config.Target, org.Guid)
+	request, err := NewRequest("DELETE", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
@@ -108,3 +108,148 @@ func TestOrganizationsFindByName(t *testing.T) {
 	org, err = repo.FindByName("org that does not exist")
 	assert.Error(t, err)
 }
+
+var orgWithQuotaAndDomainsEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/organizations/my-org-guid?inline-relations-depth=1",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "metadata": { "guid": "my-org-guid" },
+  "entity": {
+    "name": "my-org",
+    "quota_definition": {
+      "metadata": { "guid": "quota-guid" },
+      "entity": { "name": "paid", "memory_limit": 10240 }
+    },
+    "domains": [
+      { "metadata": { "guid": "domain1-guid" }, "entity": { "name": "cfapps.io" } },
+      { "metadata": { "guid": "domain2-guid" }, "entity": { "name": "example.com" } }
+    ]
+  }
+}`},
+)
+
+var orgSummaryEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/organizations/my-org-guid/summary",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "guid": "my-org-guid",
+  "name": "my-org",
+  "spaces": [
+    { "guid": "space1-guid", "name": "development", "app_count": 2, "service_count": 1 },
+    { "guid": "space2-guid", "name": "production", "app_count": 3, "service_count": 4 }
+  ]
+}`},
+)
+
+func TestOrganizationsGetSummary(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/v2/organizations/my-org-guid/summary" {
+			orgSummaryEndpoint(writer, request)
+		} else {
+			orgWithQuotaAndDomainsEndpoint(writer, request)
+		}
+	}))
+	defer ts.Close()
+
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token", Target: ts.URL}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerOrganizationRepository(config, client)
+
+	org, err := repo.GetSummary(cf.Organization{Name: "my-org", Guid: "my-org-guid"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, org.Name, "my-org")
+	assert.Equal(t, org.Guid, "my-org-guid")
+	assert.Equal(t, org.QuotaDefinition, cf.QuotaDefinition{Name: "paid", Guid: "quota-guid", MemoryLimit: 10240})
+	assert.Equal(t, len(org.Domains), 2)
+	assert.Equal(t, org.Domains[1].Name, "example.com")
+	assert.Equal(t, len(org.Spaces), 2)
+	assert.Equal(t, org.Spaces[0].Name, "development")
+	assert.Equal(t, org.AppCount, 5)
+	assert.Equal(t, org.ServiceCount, 5)
+}
+
+var createOrgEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/organizations",
+	testhelpers.RequestBodyMatcher(`{"name":"my-org"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated, Body: `
+{
+  "metadata": { "guid": "my-org-guid" },
+  "entity": { "name": "my-org" }
+}`},
+)
+
+func TestCreateOrganization(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(createOrgEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token", Target: ts.URL}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerOrganizationRepository(config, client)
+
+	org, err := repo.Create("my-org")
+	assert.NoError(t, err)
+	assert.Equal(t, org, cf.Organization{Name: "my-org", Guid: "my-org-guid"})
+}
+
+var renameOrgEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/organizations/my-org-guid",
+	testhelpers.RequestBodyMatcher(`{"name":"my-new-org"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestRenameOrganization(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(renameOrgEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token", Target: ts.URL}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerOrganizationRepository(config, client)
+
+	err := repo.Rename(cf.Organization{Guid: "my-org-guid"}, "my-new-org")
+	assert.NoError(t, err)
+}
+
+var renameOrgWithQuoteEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/organizations/my-org-guid",
+	testhelpers.RequestBodyMatcher(`{"name":"my \"new\" org"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestRenameOrganizationEscapesTheName(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(renameOrgWithQuoteEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token", Target: ts.URL}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerOrganizationRepository(config, client)
+
+	err := repo.Rename(cf.Organization{Guid: "my-org-guid"}, `my "new" org`)
+	assert.NoError(t, err)
+}
+
+var deleteOrgEndpoint = testhelpers.CreateEndpoint(
+	"DELETE",
+	"/v2/organizations/my-org-guid?recursive=true",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusNoContent},
+)
+
+func TestDeleteOrganization(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(deleteOrgEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token", Target: ts.URL}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerOrganizationRepository(config, client)
+
+	err := repo.Delete(cf.Organization{Guid: "my-org-guid"})
+	assert.NoError(t, err)
+}
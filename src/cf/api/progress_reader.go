@@ -0,0 +1,61 @@
+package api
+
+import (
+	"io"
+	"time"
+)
+
+type ProgressReporter func(bytesSent int64, totalBytes int64, bytesPerSecond int64)
+
+const progressReportInterval = 250 * time.Millisecond
+
+type ProgressReader struct {
+	reader     io.Reader
+	total      int64
+	sent       int64
+	report     ProgressReporter
+	startTime  time.Time
+	lastReport time.Time
+
+	// Readers keep reading after the last byte to find the end of the
+	// content, finishing must only be reported once.
+	reportedFinished bool
+}
+
+func NewProgressReader(reader io.Reader, total int64, report ProgressReporter) (progressReader *ProgressReader) {
+	progressReader = new(ProgressReader)
+	progressReader.reader = reader
+	progressReader.total = total
+	progressReader.report = report
+	return
+}
+
+func (r *ProgressReader) Read(p []byte) (n int, err error) {
+	if r.startTime.IsZero() {
+		r.startTime = time.Now()
+	}
+
+	n, err = r.reader.Read(p)
+	r.sent += int64(n)
+
+	if r.report == nil || r.reportedFinished {
+		return
+	}
+
+	finished := err == io.EOF || r.sent >= r.total
+	if finished || time.Since(r.lastReport) >= progressReportInterval {
+		r.lastReport = time.Now()
+		r.reportedFinished = finished
+		r.report(r.sent, r.total, r.bytesPerSecond())
+	}
+
+	return
+}
+
+func (r *ProgressReader) bytesPerSecond() int64 {
+	elapsed := time.Since(r.startTime).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return int64(float64(r.sent) / elapsed)
+}
@@ -0,0 +1,55 @@
+package api_test
+
+import (
+	. "cf/api"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestProgressReaderReportsBytesSent(t *testing.T) {
+	reports := [][]int64{}
+	reporter := func(sent int64, total int64, bytesPerSecond int64) {
+		reports = append(reports, []int64{sent, total})
+	}
+
+	reader := NewProgressReader(strings.NewReader("hello world!"), 12, reporter)
+	content, err := ioutil.ReadAll(reader)
+
+	assert.NoError(t, err)
+	assert.Equal(t, string(content), "hello world!")
+	assert.True(t, len(reports) > 0)
+	assert.Equal(t, reports[len(reports)-1], []int64{12, 12})
+}
+
+func TestProgressReaderReportsFinishingOnce(t *testing.T) {
+	finishedReports := 0
+	reporter := func(sent int64, total int64, bytesPerSecond int64) {
+		if sent == total {
+			finishedReports++
+		}
+	}
+
+	reader := NewProgressReader(strings.NewReader("hello world!"), 12, reporter)
+	buffer := make([]byte, 12)
+
+	n, err := reader.Read(buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, n, 12)
+
+	n, err = reader.Read(buffer)
+	assert.Equal(t, err, io.EOF)
+	assert.Equal(t, n, 0)
+
+	assert.Equal(t, finishedReports, 1)
+}
+
+func TestProgressReaderWithoutReporter(t *testing.T) {
+	reader := NewProgressReader(strings.NewReader("hello world!"), 12, nil)
+	content, err := ioutil.ReadAll(reader)
+
+	assert.NoError(t, err)
+	assert.Equal(t, string(content), "hello world!")
+}
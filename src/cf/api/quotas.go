@@ -0,0 +1,175 @@
+package api
+
+import (
+	"bytes"
+	"cf"
+	"cf/configuration"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type QuotaRepository interface {
+	FindAll() (quotas []cf.QuotaDefinition, err error)
+	FindByName(name string) (quota cf.QuotaDefinition, err error)
+	Create(quota cf.QuotaDefinition) (createdQuota cf.QuotaDefinition, err error)
+	Update(quota cf.QuotaDefinition) (err error)
+	AssignQuotaToOrg(org cf.Organization, quota cf.QuotaDefinition) (err error)
+	GetUsage(org cf.Organization) (usage cf.QuotaUsage, err error)
+}
+
+type CloudControllerQuotaRepository struct {
+	config    *configuration.Configuration
+	apiClient ApiClient
+}
+
+func NewCloudControllerQuotaRepository(config *configuration.Configuration, apiClient ApiClient) (repo CloudControllerQuotaRepository) {
+	repo.config = config
+	repo.apiClient = apiClient
+	return
+}
+
+func (repo CloudControllerQuotaRepository) FindAll() (quotas []cf.QuotaDefinition, err error) {
+	path := fmt.Sprintf("%s/v2/quota_definitions", repo.config.Target)
+	return repo.findAllWithPath(path)
+}
+
+func (repo CloudControllerQuotaRepository) FindByName(name string) (quota cf.QuotaDefinition, err error) {
+	path := fmt.Sprintf("%s/v2/quota_definitions?q=name%%3A%s", repo.config.Target, url.QueryEscape(name))
+	quotas, err := repo.findAllWithPath(path)
+	if err != nil {
+		return
+	}
+
+	if len(quotas) == 0 {
+		err = errors.New(fmt.Sprintf("Quota %s not found", name))
+		return
+	}
+
+	quota = quotas[0]
+	return
+}
+
+func (repo CloudControllerQuotaRepository) findAllWithPath(path string) (quotas []cf.QuotaDefinition, err error) {
+	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	response := new(QuotaDefinitionApiResponse)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, response)
+	if err != nil {
+		return
+	}
+
+	for _, r := range response.Resources {
+		quotas = append(quotas, newQuotaFromResource(r))
+	}
+	return
+}
+
+func newQuotaFromResource(r QuotaDefinitionResource) (quota cf.QuotaDefinition) {
+	quota.Name = r.Entity.Name
+	quota.Guid = r.Metadata.Guid
+	quota.MemoryLimit = r.Entity.MemoryLimit
+	quota.TotalServices = r.Entity.TotalServices
+	quota.TotalRoutes = r.Entity.TotalRoutes
+	quota.NonBasicServicesAllowed = r.Entity.NonBasicServicesAllowed
+	return
+}
+
+func (repo CloudControllerQuotaRepository) Create(quota cf.QuotaDefinition) (createdQuota cf.QuotaDefinition, err error) {
+	path := fmt.Sprintf("%s/v2/quota_definitions", repo.config.Target)
+	body, err := quotaRequestBody(quota)
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("POST", path, repo.config.AccessToken, bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+
+	resource := new(QuotaDefinitionResource)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, resource)
+	if err != nil {
+		return
+	}
+
+	createdQuota = newQuotaFromResource(*resource)
+	return
+}
+
+func (repo CloudControllerQuotaRepository) Update(quota cf.QuotaDefinition) (err error) {
+	path := fmt.Sprintf("%s/v2/quota_definitions/%s", repo.config.Target, quota.Guid)
+	body, err := quotaRequestBody(quota)
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("PUT", path, repo.config.AccessToken, bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func quotaRequestBody(quota cf.QuotaDefinition) ([]byte, error) {
+	type RequestBody struct {
+		Name                    string `json:"name"`
+		MemoryLimit             uint64 `json:"memory_limit"`
+		TotalServices           int    `json:"total_services"`
+		TotalRoutes             int    `json:"total_routes"`
+		NonBasicServicesAllowed bool   `json:"non_basic_services_allowed"`
+	}
+
+	return json.Marshal(RequestBody{quota.Name, quota.MemoryLimit, quota.TotalServices, quota.TotalRoutes, quota.NonBasicServicesAllowed})
+}
+
+func (repo CloudControllerQuotaRepository) AssignQuotaToOrg(org cf.Organization, quota cf.QuotaDefinition) (err error) {
+	path := fmt.Sprintf("%s/v2/organizations/%s", repo.config.Target, org.Guid)
+	data := fmt.Sprintf(`{"quota_definition_guid":"%s"}`, quota.Guid)
+	request, err := NewRequest("PUT", path, repo.config.AccessToken, strings.NewReader(data))
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+// GetUsage adds up the memory and routes the org consumes. Its service
+// count is part of the org summary, see OrganizationRepository.GetSummary.
+func (repo CloudControllerQuotaRepository) GetUsage(org cf.Organization) (usage cf.QuotaUsage, err error) {
+	memoryResponse := new(MemoryUsageResponse)
+	path := fmt.Sprintf("%s/v2/organizations/%s/memory_usage", repo.config.Target, org.Guid)
+	err = repo.getResponse(path, memoryResponse)
+	if err != nil {
+		return
+	}
+	usage.Memory = memoryResponse.MemoryUsage
+
+	routesResponse := new(TotalResultsResponse)
+	path = fmt.Sprintf("%s/v2/routes?q=organization_guid%%3A%s&results-per-page=1", repo.config.Target, org.Guid)
+	err = repo.getResponse(path, routesResponse)
+	if err != nil {
+		return
+	}
+	usage.Routes = routesResponse.TotalResults
+
+	return
+}
+
+func (repo CloudControllerQuotaRepository) getResponse(path string, response interface{}) (err error) {
+	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, response)
+	return
+}
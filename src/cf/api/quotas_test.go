@@ -0,0 +1,250 @@
+package api_test
+
+import (
+	"cf"
+	. "cf/api"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testhelpers"
+	"testing"
+)
+
+var quotasEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/quota_definitions",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    {
+      "metadata": { "guid": "quota-1-guid" },
+      "entity": {
+        "name": "quota-1",
+        "memory_limit": 1024,
+        "total_services": 10,
+        "total_routes": 100,
+        "non_basic_services_allowed": false
+      }
+    },
+    {
+      "metadata": { "guid": "quota-2-guid" },
+      "entity": {
+        "name": "quota-2",
+        "memory_limit": 10240,
+        "total_services": 100,
+        "total_routes": 1000,
+        "non_basic_services_allowed": true
+      }
+    }
+  ]
+}`},
+)
+
+func TestQuotasFindAll(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(quotasEndpoint))
+	defer ts.Close()
+
+	repo := createQuotaRepo(ts.URL)
+
+	quotas, err := repo.FindAll()
+	assert.NoError(t, err)
+	assert.Equal(t, len(quotas), 2)
+	assert.Equal(t, quotas[0], cf.QuotaDefinition{
+		Name:          "quota-1",
+		Guid:          "quota-1-guid",
+		MemoryLimit:   1024,
+		TotalServices: 10,
+		TotalRoutes:   100,
+	})
+	assert.Equal(t, quotas[1].Name, "quota-2")
+	assert.True(t, quotas[1].NonBasicServicesAllowed)
+}
+
+var findQuotaByNameEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/quota_definitions?q=name%3Amy-quota",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    {
+      "metadata": { "guid": "my-quota-guid" },
+      "entity": { "name": "my-quota", "memory_limit": 1024 }
+    }
+  ]
+}`},
+)
+
+func TestQuotasFindByName(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findQuotaByNameEndpoint))
+	defer ts.Close()
+
+	repo := createQuotaRepo(ts.URL)
+
+	quota, err := repo.FindByName("my-quota")
+	assert.NoError(t, err)
+	assert.Equal(t, quota.Name, "my-quota")
+	assert.Equal(t, quota.Guid, "my-quota-guid")
+	assert.Equal(t, quota.MemoryLimit, uint64(1024))
+}
+
+var findQuotaByNameWithSpaceEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/quota_definitions?q=name%3AMy+Quota",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    {
+      "metadata": { "guid": "my-quota-guid" },
+      "entity": { "name": "My Quota", "memory_limit": 1024 }
+    }
+  ]
+}`},
+)
+
+func TestQuotasFindByNameEscapesTheName(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findQuotaByNameWithSpaceEndpoint))
+	defer ts.Close()
+
+	repo := createQuotaRepo(ts.URL)
+
+	quota, err := repo.FindByName("My Quota")
+	assert.NoError(t, err)
+	assert.Equal(t, quota.Guid, "my-quota-guid")
+}
+
+var findQuotaByNameNotFoundEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/quota_definitions?q=name%3Amy-quota",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `{ "resources": [] }`},
+)
+
+func TestQuotasFindByNameWhenNotFound(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findQuotaByNameNotFoundEndpoint))
+	defer ts.Close()
+
+	repo := createQuotaRepo(ts.URL)
+
+	_, err := repo.FindByName("my-quota")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Quota my-quota not found")
+}
+
+var createQuotaEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/quota_definitions",
+	testhelpers.RequestBodyMatcher(`{"name":"my-quota","memory_limit":2048,"total_services":5,"total_routes":50,"non_basic_services_allowed":true}`),
+	testhelpers.TestResponse{Status: http.StatusCreated, Body: `
+{
+  "metadata": { "guid": "my-quota-guid" },
+  "entity": {
+    "name": "my-quota",
+    "memory_limit": 2048,
+    "total_services": 5,
+    "total_routes": 50,
+    "non_basic_services_allowed": true
+  }
+}`},
+)
+
+func TestQuotasCreate(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(createQuotaEndpoint))
+	defer ts.Close()
+
+	repo := createQuotaRepo(ts.URL)
+
+	quota := cf.QuotaDefinition{
+		Name:                    "my-quota",
+		MemoryLimit:             2048,
+		TotalServices:           5,
+		TotalRoutes:             50,
+		NonBasicServicesAllowed: true,
+	}
+	createdQuota, err := repo.Create(quota)
+	assert.NoError(t, err)
+
+	quota.Guid = "my-quota-guid"
+	assert.Equal(t, createdQuota, quota)
+}
+
+var updateQuotaEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/quota_definitions/my-quota-guid",
+	testhelpers.RequestBodyMatcher(`{"name":"my-quota","memory_limit":4096,"total_services":5,"total_routes":50,"non_basic_services_allowed":false}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestQuotasUpdate(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(updateQuotaEndpoint))
+	defer ts.Close()
+
+	repo := createQuotaRepo(ts.URL)
+
+	err := repo.Update(cf.QuotaDefinition{
+		Name:          "my-quota",
+		Guid:          "my-quota-guid",
+		MemoryLimit:   4096,
+		TotalServices: 5,
+		TotalRoutes:   50,
+	})
+	assert.NoError(t, err)
+}
+
+var assignQuotaEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/organizations/my-org-guid",
+	testhelpers.RequestBodyMatcher(`{"quota_definition_guid":"my-quota-guid"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestQuotasAssignQuotaToOrg(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(assignQuotaEndpoint))
+	defer ts.Close()
+
+	repo := createQuotaRepo(ts.URL)
+
+	err := repo.AssignQuotaToOrg(cf.Organization{Guid: "my-org-guid"}, cf.QuotaDefinition{Guid: "my-quota-guid"})
+	assert.NoError(t, err)
+}
+
+var orgMemoryUsageEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/organizations/my-org-guid/memory_usage",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `{ "memory_usage_in_mb": 768 }`},
+)
+
+var orgRoutesCountEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/routes?q=organization_guid%3Amy-org-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `{ "total_results": 7, "resources": [] }`},
+)
+
+func TestQuotasGetUsage(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		switch request.URL.Path {
+		case "/v2/organizations/my-org-guid/memory_usage":
+			orgMemoryUsageEndpoint(writer, request)
+		default:
+			orgRoutesCountEndpoint(writer, request)
+		}
+	}))
+	defer ts.Close()
+
+	repo := createQuotaRepo(ts.URL)
+
+	usage, err := repo.GetUsage(cf.Organization{Guid: "my-org-guid"})
+	assert.NoError(t, err)
+	assert.Equal(t, usage, cf.QuotaUsage{Memory: 768, Routes: 7})
+}
+
+func createQuotaRepo(target string) (repo QuotaRepository) {
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token", Target: target}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	return NewCloudControllerQuotaRepository(config, client)
+}
@@ -7,14 +7,18 @@ import (
 type RepositoryLocator struct {
 	config *configuration.Configuration
 
-	configurationRepo configuration.ConfigurationDiskRepository
-	organizationRepo  CloudControllerOrganizationRepository
-	spaceRepo         CloudControllerSpaceRepository
-	appRepo           CloudControllerApplicationRepository
-	domainRepo        CloudControllerDomainRepository
-	routeRepo         CloudControllerRouteRepository
-	stackRepo         CloudControllerStackRepository
-	serviceRepo       CloudControllerServiceRepository
+	configurationRepo  configuration.ConfigurationDiskRepository
+	organizationRepo   CloudControllerOrganizationRepository
+	spaceRepo          CloudControllerSpaceRepository
+	appRepo            CloudControllerApplicationRepository
+	domainRepo         CloudControllerDomainRepository
+	routeRepo          CloudControllerRouteRepository
+	stackRepo          CloudControllerStackRepository
+	serviceRepo        CloudControllerServiceRepository
+	serviceBrokerRepo  CloudControllerServiceBrokerRepository
+	planVisibilityRepo CloudControllerServicePlanVisibilityRepository
+	userRepo           CloudControllerUserRepository
+	quotaRepo          CloudControllerQuotaRepository
 }
 
 func NewRepositoryLocator(config *configuration.Configuration) (locator RepositoryLocator) {
@@ -31,6 +35,10 @@ func NewRepositoryLocator(config *configuration.Configuration) (locator Reposito
 	locator.routeRepo = NewCloudControllerRouteRepository(config, apiClient)
 	locator.stackRepo = NewCloudControllerStackRepository(config, apiClient)
 	locator.serviceRepo = NewCloudControllerServiceRepository(config, apiClient)
+	locator.serviceBrokerRepo = NewCloudControllerServiceBrokerRepository(config, apiClient)
+	locator.planVisibilityRepo = NewCloudControllerServicePlanVisibilityRepository(config, apiClient)
+	locator.userRepo = NewCloudControllerUserRepository(config, apiClient)
+	locator.quotaRepo = NewCloudControllerQuotaRepository(config, apiClient)
 
 	return
 }
@@ -70,3 +78,19 @@ func (locator RepositoryLocator) GetStackRepository() StackRepository {
 func (locator RepositoryLocator) GetServiceRepository() ServiceRepository {
 	return locator.serviceRepo
 }
+
+func (locator RepositoryLocator) GetServiceBrokerRepository() ServiceBrokerRepository {
+	return locator.serviceBrokerRepo
+}
+
+func (locator RepositoryLocator) GetServicePlanVisibilityRepository() ServicePlanVisibilityRepository {
+	return locator.planVisibilityRepo
+}
+
+func (locator RepositoryLocator) GetUserRepository() UserRepository {
+	return locator.userRepo
+}
+
+func (locator RepositoryLocator) GetQuotaRepository() QuotaRepository {
+	return locator.quotaRepo
+}
@@ -46,8 +46,75 @@ type RouteResource struct {
 }
 
 type RouteEntity struct {
-	Host   string
-	Domain Resource
+	Host       string
+	DomainGuid string `json:"domain_guid"`
+	Domain     Resource
+	Space      Resource
+	Apps       []Resource
+}
+
+type DomainApiResponse struct {
+	Resources []DomainResource
+}
+
+type DomainResource struct {
+	Metadata Metadata
+	Entity   DomainEntity
+}
+
+type DomainEntity struct {
+	Name                   string
+	OwningOrganizationGuid string   `json:"owning_organization_guid"`
+	OwningOrganization     Resource `json:"owning_organization"`
+}
+
+type OrganizationSummary struct {
+	Guid   string
+	Name   string
+	Spaces []SpaceCountsSummary
+}
+
+type SpaceCountsSummary struct {
+	Guid         string
+	Name         string
+	AppCount     int `json:"app_count"`
+	ServiceCount int `json:"service_count"`
+}
+
+type OrganizationResource struct {
+	Metadata Metadata
+	Entity   OrganizationEntity
+}
+
+type OrganizationEntity struct {
+	Name            string
+	QuotaDefinition QuotaDefinitionResource `json:"quota_definition"`
+	Domains         []Resource
+}
+
+type QuotaDefinitionResource struct {
+	Metadata Metadata
+	Entity   QuotaDefinitionEntity
+}
+
+type QuotaDefinitionEntity struct {
+	Name                    string
+	MemoryLimit             uint64 `json:"memory_limit"`
+	TotalServices           int    `json:"total_services"`
+	TotalRoutes             int    `json:"total_routes"`
+	NonBasicServicesAllowed bool   `json:"non_basic_services_allowed"`
+}
+
+type QuotaDefinitionApiResponse struct {
+	Resources []QuotaDefinitionResource
+}
+
+type MemoryUsageResponse struct {
+	MemoryUsage uint64 `json:"memory_usage_in_mb"`
+}
+
+type TotalResultsResponse struct {
+	TotalResults int `json:"total_results"`
 }
 
 type ApplicationSummary struct {
@@ -113,9 +180,61 @@ type ServiceOfferingEntity struct {
 	ServicePlans []ServicePlanResource `json:"service_plans"`
 }
 
+type ServiceBrokersApiResponse struct {
+	Resources []ServiceBrokerResource
+}
+
+type ServiceBrokerResource struct {
+	Metadata Metadata
+	Entity   ServiceBrokerEntity
+}
+
+type ServiceBrokerEntity struct {
+	Name     string
+	Url      string `json:"broker_url"`
+	Username string `json:"auth_username"`
+}
+
+type ServicePlansApiResponse struct {
+	Resources []ServicePlanResource
+}
+
 type ServicePlanResource struct {
 	Metadata Metadata
-	Entity   Entity
+	Entity   ServicePlanEntity
+}
+
+type ServicePlanEntity struct {
+	Name            string
+	Description     string
+	Free            bool
+	Public          bool
+	Extra           string
+	ServiceOffering ServiceOfferingResource `json:"service"`
+}
+
+type ServicePlanVisibilitiesApiResponse struct {
+	Resources []ServicePlanVisibilityResource
+}
+
+type ServicePlanVisibilityResource struct {
+	Metadata Metadata
+	Entity   ServicePlanVisibilityEntity
+}
+
+type ServicePlanVisibilityEntity struct {
+	ServicePlanGuid  string `json:"service_plan_guid"`
+	OrganizationGuid string `json:"organization_guid"`
+}
+
+// ServicePlanExtra is the JSON document brokers put in a plan's "extra" field.
+type ServicePlanExtra struct {
+	Costs []ServicePlanCost
+}
+
+type ServicePlanCost struct {
+	Amount map[string]float64
+	Unit   string
 }
 
 type ServiceInstancesApiResponse struct {
@@ -129,9 +248,18 @@ type ServiceInstanceResource struct {
 
 type ServiceInstanceEntity struct {
 	Name            string
+	DashboardUrl    string                   `json:"dashboard_url"`
+	LastOperation   LastOperation            `json:"last_operation"`
+	ServicePlan     ServicePlanResource      `json:"service_plan"`
 	ServiceBindings []ServiceBindingResource `json:"service_bindings"`
 }
 
+type LastOperation struct {
+	Type        string
+	State       string
+	Description string
+}
+
 type ServiceBindingResource struct {
 	Metadata Metadata
 	Entity   ServiceBindingEntity
@@ -139,6 +267,22 @@ type ServiceBindingResource struct {
 
 type ServiceBindingEntity struct {
 	AppGuid string `json:"app_guid"`
+	App     ApplicationResource
+}
+
+type ServiceKeysApiResponse struct {
+	Resources []ServiceKeyResource
+}
+
+type ServiceKeyResource struct {
+	Metadata Metadata
+	Entity   ServiceKeyEntity
+}
+
+type ServiceKeyEntity struct {
+	Name                string
+	ServiceInstanceGuid string `json:"service_instance_guid"`
+	Credentials         map[string]interface{}
 }
 
 type StackApiResponse struct {
@@ -154,3 +298,12 @@ type StackResource struct {
 	Metadata Metadata
 	Entity   StackEntity
 }
+
+type UAAUserResources struct {
+	Resources []UAAUserResource
+}
+
+type UAAUserResource struct {
+	Id       string
+	Username string `json:"userName"`
+}
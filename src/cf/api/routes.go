@@ -9,10 +9,14 @@ import (
 )
 
 type RouteRepository interface {
-	FindAll() (routes []cf.Route, err error)
-	FindByHost(host string) (route cf.Route, err error)
-	Create(newRoute cf.Route, domain cf.Domain) (createdRoute cf.Route, err error)
+	FindAllInCurrentSpace() (routes []cf.Route, err error)
+	FindAllInCurrentOrg() (routes []cf.Route, err error)
+	FindByHostAndDomain(host string, domain cf.Domain) (route cf.Route, err error)
+	Create(newRoute cf.Route, domain cf.Domain) (createdRoute cf.Route, errorCode int, err error)
+	CreateInSpace(newRoute cf.Route, domain cf.Domain, space cf.Space) (createdRoute cf.Route, errorCode int, err error)
 	Bind(route cf.Route, app cf.Application) (err error)
+	Unbind(route cf.Route, app cf.Application) (err error)
+	Delete(route cf.Route) (err error)
 }
 
 type CloudControllerRouteRepository struct {
@@ -26,9 +30,17 @@ func NewCloudControllerRouteRepository(config *configuration.Configuration, apiC
 	return
 }
 
-func (repo CloudControllerRouteRepository) FindAll() (routes []cf.Route, err error) {
-	path := fmt.Sprintf("%s/v2/routes?inline-relations-depth=1", repo.config.Target)
+func (repo CloudControllerRouteRepository) FindAllInCurrentSpace() (routes []cf.Route, err error) {
+	path := fmt.Sprintf("%s/v2/spaces/%s/routes?inline-relations-depth=1", repo.config.Target, repo.config.Space.Guid)
+	return repo.findAllWithPath(path)
+}
+
+func (repo CloudControllerRouteRepository) FindAllInCurrentOrg() (routes []cf.Route, err error) {
+	path := fmt.Sprintf("%s/v2/routes?inline-relations-depth=1&q=organization_guid%s", repo.config.Target, "%3A"+repo.config.Organization.Guid)
+	return repo.findAllWithPath(path)
+}
 
+func (repo CloudControllerRouteRepository) findAllWithPath(path string) (routes []cf.Route, err error) {
 	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
 	if err != nil {
 		return
@@ -41,6 +53,11 @@ func (repo CloudControllerRouteRepository) FindAll() (routes []cf.Route, err err
 	}
 
 	for _, routeResponse := range response.Routes {
+		appNames := []string{}
+		for _, app := range routeResponse.Entity.Apps {
+			appNames = append(appNames, app.Entity.Name)
+		}
+
 		routes = append(routes,
 			cf.Route{
 				Host: routeResponse.Entity.Host,
@@ -49,43 +66,56 @@ func (repo CloudControllerRouteRepository) FindAll() (routes []cf.Route, err err
 					Name: routeResponse.Entity.Domain.Entity.Name,
 					Guid: routeResponse.Entity.Domain.Metadata.Guid,
 				},
+				Space: cf.Space{
+					Name: routeResponse.Entity.Space.Entity.Name,
+					Guid: routeResponse.Entity.Space.Metadata.Guid,
+				},
+				AppNames: appNames,
 			},
 		)
 	}
+
 	return
 }
 
-func (repo CloudControllerRouteRepository) FindByHost(host string) (route cf.Route, err error) {
-	path := fmt.Sprintf("%s/v2/routes?q=host%s", repo.config.Target, "%3A"+host)
+func (repo CloudControllerRouteRepository) FindByHostAndDomain(host string, domain cf.Domain) (route cf.Route, err error) {
+	path := fmt.Sprintf("%s/v2/routes?q=host%s%s", repo.config.Target, "%3A"+host, "%3Bdomain_guid%3A"+domain.Guid)
 
 	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
 	if err != nil {
 		return
 	}
 
-	response := new(ApiResponse)
+	response := new(RoutesResponse)
 	_, err = repo.apiClient.PerformRequestAndParseResponse(request, response)
 	if err != nil {
 		return
 	}
 
-	if len(response.Resources) == 0 {
-		err = errors.New("Route not found")
-		return
+	// The same host can exist on several domains, so never trust
+	// a result that does not belong to the requested domain.
+	for _, resource := range response.Routes {
+		if resource.Entity.Host == host && resource.Entity.DomainGuid == domain.Guid {
+			route.Guid = resource.Metadata.Guid
+			route.Host = resource.Entity.Host
+			route.Domain = domain
+			return
+		}
 	}
 
-	resource := response.Resources[0]
-	route.Guid = resource.Metadata.Guid
-	route.Host = resource.Entity.Host
-
+	err = errors.New("Route not found")
 	return
 }
 
-func (repo CloudControllerRouteRepository) Create(newRoute cf.Route, domain cf.Domain) (createdRoute cf.Route, err error) {
+func (repo CloudControllerRouteRepository) Create(newRoute cf.Route, domain cf.Domain) (createdRoute cf.Route, errorCode int, err error) {
+	return repo.CreateInSpace(newRoute, domain, repo.config.Space)
+}
+
+func (repo CloudControllerRouteRepository) CreateInSpace(newRoute cf.Route, domain cf.Domain, space cf.Space) (createdRoute cf.Route, errorCode int, err error) {
 	path := fmt.Sprintf("%s/v2/routes", repo.config.Target)
 	data := fmt.Sprintf(
 		`{"host":"%s","domain_guid":"%s","space_guid":"%s"}`,
-		newRoute.Host, domain.Guid, repo.config.Space.Guid,
+		newRoute.Host, domain.Guid, space.Guid,
 	)
 	request, err := NewRequest("POST", path, repo.config.AccessToken, strings.NewReader(data))
 	if err != nil {
@@ -93,7 +123,7 @@ func (repo CloudControllerRouteRepository) Create(newRoute cf.Route, domain cf.D
 	}
 
 	resource := new(Resource)
-	_, err = repo.apiClient.PerformRequestAndParseResponse(request, resource)
+	errorCode, err = repo.apiClient.PerformRequestAndParseResponse(request, resource)
 	if err != nil {
 		return
 	}
@@ -114,3 +144,25 @@ func (repo CloudControllerRouteRepository) Bind(route cf.Route, app cf.Applicati
 
 	return
 }
+
+func (repo CloudControllerRouteRepository) Unbind(route cf.Route, app cf.Application) (err error) {
+	path := fmt.Sprintf("%s/v2/apps/%s/routes/%s", repo.config.Target, app.Guid, route.Guid)
+	request, err := NewRequest("DELETE", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func (repo CloudControllerRouteRepository) Delete(route cf.Route) (err error) {
+	path := fmt.Sprintf("%s/v2/routes/%s", repo.config.Target, route.Guid)
+	request, err := NewRequest("DELETE", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
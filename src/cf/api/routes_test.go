@@ -31,7 +31,33 @@ var findAllRoutesResponse = testhelpers.TestResponse{Status: http.StatusOK, Body
           "entity": {
             "name": "cfapps.io"
           }
-        }
+        },
+        "space": {
+          "metadata": {
+            "guid": "my-space-guid"
+          },
+          "entity": {
+            "name": "my-space"
+          }
+        },
+        "apps": [
+          {
+            "metadata": {
+              "guid": "app-1-guid"
+            },
+            "entity": {
+              "name": "app-1"
+            }
+          },
+          {
+            "metadata": {
+              "guid": "app-2-guid"
+            },
+            "entity": {
+              "name": "app-2"
+            }
+          }
+        ]
       }
     },
     {
@@ -53,25 +79,26 @@ var findAllRoutesResponse = testhelpers.TestResponse{Status: http.StatusOK, Body
   ]
 }`}
 
-var findAllEndpoint = testhelpers.CreateEndpoint(
+var findAllInSpaceEndpoint = testhelpers.CreateEndpoint(
 	"GET",
-	"/v2/routes?inline-relations-depth=1",
+	"/v2/spaces/my-space-guid/routes?inline-relations-depth=1",
 	nil,
 	findAllRoutesResponse,
 )
 
-func TestRoutesFindAll(t *testing.T) {
-	ts := httptest.NewTLSServer(http.HandlerFunc(findAllEndpoint))
+func TestRoutesFindAllInCurrentSpace(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findAllInSpaceEndpoint))
 	defer ts.Close()
 
 	config := &configuration.Configuration{
 		AccessToken: "BEARER my_access_token",
 		Target:      ts.URL,
+		Space:       cf.Space{Guid: "my-space-guid"},
 	}
 	client := NewApiClient(&testhelpers.FakeAuthenticator{})
 	repo := NewCloudControllerRouteRepository(config, client)
 
-	routes, err := repo.FindAll()
+	routes, err := repo.FindAllInCurrentSpace()
 	assert.NoError(t, err)
 
 	assert.Equal(t, len(routes), 2)
@@ -81,32 +108,70 @@ func TestRoutesFindAll(t *testing.T) {
 	assert.Equal(t, route.Guid, "route-1-guid")
 	assert.Equal(t, route.Domain.Name, "cfapps.io")
 	assert.Equal(t, route.Domain.Guid, "domain-1-guid")
+	assert.Equal(t, route.Space.Name, "my-space")
+	assert.Equal(t, route.AppNames, []string{"app-1", "app-2"})
 
 	route = routes[1]
 	assert.Equal(t, route.Guid, "route-2-guid")
+	assert.Equal(t, len(route.AppNames), 0)
 }
 
-var findRouteByHostResponse = testhelpers.TestResponse{Status: http.StatusCreated, Body: `
+var findAllInOrgEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/routes?inline-relations-depth=1&q=organization_guid%3Amy-org-guid",
+	nil,
+	findAllRoutesResponse,
+)
+
+func TestRoutesFindAllInCurrentOrg(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findAllInOrgEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken:  "BEARER my_access_token",
+		Target:       ts.URL,
+		Organization: cf.Organization{Guid: "my-org-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerRouteRepository(config, client)
+
+	routes, err := repo.FindAllInCurrentOrg()
+	assert.NoError(t, err)
+	assert.Equal(t, len(routes), 2)
+	assert.Equal(t, routes[0].Guid, "route-1-guid")
+}
+
+var findRouteByHostAndDomainResponse = testhelpers.TestResponse{Status: http.StatusOK, Body: `
 { "resources": [
     {
-    	"metadata": {
-        	"guid": "my-route-guid"
-    	},
-    	"entity": {
-       	     "host": "my-cool-app"
-    	}
+      "metadata": {
+        "guid": "other-domain-route-guid"
+      },
+      "entity": {
+        "host": "my-cool-app",
+        "domain_guid": "other-domain-guid"
+      }
+    },
+    {
+      "metadata": {
+        "guid": "my-route-guid"
+      },
+      "entity": {
+        "host": "my-cool-app",
+        "domain_guid": "my-domain-guid"
+      }
     }
 ]}`}
 
-var findRouteByHostEndpoint = testhelpers.CreateEndpoint(
+var findRouteByHostAndDomainEndpoint = testhelpers.CreateEndpoint(
 	"GET",
-	"/v2/routes?q=host%3Amy-cool-app",
+	"/v2/routes?q=host%3Amy-cool-app%3Bdomain_guid%3Amy-domain-guid",
 	nil,
-	findRouteByHostResponse,
+	findRouteByHostAndDomainResponse,
 )
 
-func TestFindByHost(t *testing.T) {
-	ts := httptest.NewTLSServer(http.HandlerFunc(findRouteByHostEndpoint))
+func TestFindByHostAndDomainWithCollidingHostnames(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findRouteByHostAndDomainEndpoint))
 	defer ts.Close()
 
 	config := &configuration.Configuration{
@@ -116,24 +181,21 @@ func TestFindByHost(t *testing.T) {
 	client := NewApiClient(&testhelpers.FakeAuthenticator{})
 	repo := NewCloudControllerRouteRepository(config, client)
 
-	route, err := repo.FindByHost("my-cool-app")
+	domain := cf.Domain{Name: "cfapps.io", Guid: "my-domain-guid"}
+	route, err := repo.FindByHostAndDomain("my-cool-app", domain)
 	assert.NoError(t, err)
-	assert.Equal(t, route, cf.Route{Host: "my-cool-app", Guid: "my-route-guid"})
+	assert.Equal(t, route, cf.Route{Host: "my-cool-app", Guid: "my-route-guid", Domain: domain})
 }
 
-var findRouteByHostNotFoundResponse = testhelpers.TestResponse{Status: http.StatusCreated, Body: `
-{ "resources": [
-]}`}
-
-var findRouteByHostNotFoundEndpoint = testhelpers.CreateEndpoint(
+var findRouteByHostAndDomainOnOtherDomainEndpoint = testhelpers.CreateEndpoint(
 	"GET",
-	"/v2/routes?q=host%3Amy-cool-app",
+	"/v2/routes?q=host%3Amy-cool-app%3Bdomain_guid%3Aunused-domain-guid",
 	nil,
-	findRouteByHostNotFoundResponse,
+	findRouteByHostAndDomainResponse,
 )
 
-func TestFindByHostWhenHostIsNotFound(t *testing.T) {
-	ts := httptest.NewTLSServer(http.HandlerFunc(findRouteByHostNotFoundEndpoint))
+func TestFindByHostAndDomainWhenHostOnlyExistsOnOtherDomains(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findRouteByHostAndDomainOnOtherDomainEndpoint))
 	defer ts.Close()
 
 	config := &configuration.Configuration{
@@ -143,7 +205,8 @@ func TestFindByHostWhenHostIsNotFound(t *testing.T) {
 	client := NewApiClient(&testhelpers.FakeAuthenticator{})
 	repo := NewCloudControllerRouteRepository(config, client)
 
-	_, err := repo.FindByHost("my-cool-app")
+	domain := cf.Domain{Name: "example.com", Guid: "unused-domain-guid"}
+	_, err := repo.FindByHostAndDomain("my-cool-app", domain)
 	assert.Error(t, err)
 }
 
@@ -179,7 +242,7 @@ func TestCreate(t *testing.T) {
 	domain := cf.Domain{Guid: "my-domain-guid"}
 	newRoute := cf.Route{Host: "my-cool-app"}
 
-	createdRoute, err := repo.Create(newRoute, domain)
+	createdRoute, _, err := repo.Create(newRoute, domain)
 	assert.NoError(t, err)
 
 	assert.Equal(t, createdRoute, cf.Route{Host: "my-cool-app", Guid: "my-route-guid"})
@@ -209,3 +272,105 @@ func TestBind(t *testing.T) {
 	err := repo.Bind(route, app)
 	assert.NoError(t, err)
 }
+
+var createRouteInSpaceEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/routes",
+	testhelpers.RequestBodyMatcher(`{"host":"my-cool-app","domain_guid":"my-domain-guid","space_guid":"other-space-guid"}`),
+	createRouteResponse,
+)
+
+func TestCreateInSpace(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(createRouteInSpaceEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+		Space:       cf.Space{Guid: "my-space-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerRouteRepository(config, client)
+
+	domain := cf.Domain{Guid: "my-domain-guid"}
+	space := cf.Space{Guid: "other-space-guid"}
+	newRoute := cf.Route{Host: "my-cool-app"}
+
+	createdRoute, _, err := repo.CreateInSpace(newRoute, domain, space)
+	assert.NoError(t, err)
+
+	assert.Equal(t, createdRoute, cf.Route{Host: "my-cool-app", Guid: "my-route-guid"})
+}
+
+var unbindRouteEndpoint = testhelpers.CreateEndpoint(
+	"DELETE",
+	"/v2/apps/my-cool-app-guid/routes/my-cool-route-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusCreated, Body: ""},
+)
+
+func TestUnbind(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(unbindRouteEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerRouteRepository(config, client)
+
+	route := cf.Route{Guid: "my-cool-route-guid"}
+	app := cf.Application{Guid: "my-cool-app-guid"}
+
+	err := repo.Unbind(route, app)
+	assert.NoError(t, err)
+}
+
+var deleteRouteEndpoint = testhelpers.CreateEndpoint(
+	"DELETE",
+	"/v2/routes/my-cool-route-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusNoContent, Body: ""},
+)
+
+func TestDeleteRoute(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(deleteRouteEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerRouteRepository(config, client)
+
+	route := cf.Route{Guid: "my-cool-route-guid"}
+
+	err := repo.Delete(route)
+	assert.NoError(t, err)
+}
+
+var createTakenRouteEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/routes",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusBadRequest, Body: `{"code":210003,"description":"The host is taken: my-cool-app"}`},
+)
+
+func TestCreateReturnsErrorCodeWhenHostIsTaken(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(createTakenRouteEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+		Space:       cf.Space{Guid: "my-space-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerRouteRepository(config, client)
+
+	_, errorCode, err := repo.Create(cf.Route{Host: "my-cool-app"}, cf.Domain{Guid: "my-domain-guid"})
+	assert.Error(t, err)
+	assert.Equal(t, errorCode, 210003)
+}
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"bytes"
+	"cf"
+	"cf/configuration"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+type ServiceBrokerRepository interface {
+	FindAll() (brokers []cf.ServiceBroker, err error)
+	FindByName(name string) (broker cf.ServiceBroker, err error)
+	Create(broker cf.ServiceBroker) (err error)
+	Update(broker cf.ServiceBroker) (err error)
+	Rename(broker cf.ServiceBroker, newName string) (err error)
+	Delete(broker cf.ServiceBroker) (err error)
+}
+
+type CloudControllerServiceBrokerRepository struct {
+	config    *configuration.Configuration
+	apiClient ApiClient
+}
+
+func NewCloudControllerServiceBrokerRepository(config *configuration.Configuration, apiClient ApiClient) (repo CloudControllerServiceBrokerRepository) {
+	repo.config = config
+	repo.apiClient = apiClient
+	return
+}
+
+func (repo CloudControllerServiceBrokerRepository) FindAll() (brokers []cf.ServiceBroker, err error) {
+	path := fmt.Sprintf("%s/v2/service_brokers", repo.config.Target)
+	return repo.findAllWithPath(path)
+}
+
+func (repo CloudControllerServiceBrokerRepository) FindByName(name string) (broker cf.ServiceBroker, err error) {
+	path := fmt.Sprintf("%s/v2/service_brokers?q=name%%3A%s", repo.config.Target, url.QueryEscape(name))
+	brokers, err := repo.findAllWithPath(path)
+	if err != nil {
+		return
+	}
+
+	if len(brokers) == 0 {
+		err = errors.New(fmt.Sprintf("Service broker %s not found", name))
+		return
+	}
+
+	broker = brokers[0]
+	return
+}
+
+func (repo CloudControllerServiceBrokerRepository) findAllWithPath(path string) (brokers []cf.ServiceBroker, err error) {
+	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	response := new(ServiceBrokersApiResponse)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, response)
+	if err != nil {
+		return
+	}
+
+	for _, r := range response.Resources {
+		brokers = append(brokers, cf.ServiceBroker{
+			Name:     r.Entity.Name,
+			Guid:     r.Metadata.Guid,
+			Url:      r.Entity.Url,
+			Username: r.Entity.Username,
+		})
+	}
+	return
+}
+
+func (repo CloudControllerServiceBrokerRepository) Create(broker cf.ServiceBroker) (err error) {
+	path := fmt.Sprintf("%s/v2/service_brokers", repo.config.Target)
+
+	type RequestBody struct {
+		Name     string `json:"name"`
+		Url      string `json:"broker_url"`
+		Username string `json:"auth_username"`
+		Password string `json:"auth_password"`
+	}
+
+	return repo.sendBody("POST", path, RequestBody{broker.Name, broker.Url, broker.Username, broker.Password})
+}
+
+// Update changes where the broker is reached and how, its name stays as is.
+func (repo CloudControllerServiceBrokerRepository) Update(broker cf.ServiceBroker) (err error) {
+	path := fmt.Sprintf("%s/v2/service_brokers/%s", repo.config.Target, broker.Guid)
+
+	type RequestBody struct {
+		Url      string `json:"broker_url"`
+		Username string `json:"auth_username"`
+		Password string `json:"auth_password"`
+	}
+
+	return repo.sendBody("PUT", path, RequestBody{broker.Url, broker.Username, broker.Password})
+}
+
+func (repo CloudControllerServiceBrokerRepository) Rename(broker cf.ServiceBroker, newName string) (err error) {
+	path := fmt.Sprintf("%s/v2/service_brokers/%s", repo.config.Target, broker.Guid)
+
+	type RequestBody struct {
+		Name string `json:"name"`
+	}
+
+	return repo.sendBody("PUT", path, RequestBody{newName})
+}
+
+func (repo CloudControllerServiceBrokerRepository) Delete(broker cf.ServiceBroker) (err error) {
+	path := fmt.Sprintf("%s/v2/service_brokers/%s", repo.config.Target, broker.Guid)
+	request, err := NewRequest("DELETE", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func (repo CloudControllerServiceBrokerRepository) sendBody(method string, path string, body interface{}) (err error) {
+	jsonBytes, err := json.Marshal(body)
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest(method, path, repo.config.AccessToken, bytes.NewReader(jsonBytes))
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
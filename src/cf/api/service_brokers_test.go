@@ -0,0 +1,190 @@
+package api_test
+
+import (
+	"cf"
+	. "cf/api"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testhelpers"
+	"testing"
+)
+
+var serviceBrokersEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/service_brokers",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    {
+      "metadata": { "guid": "broker-1-guid" },
+      "entity": {
+        "name": "broker-1",
+        "broker_url": "http://broker-1.example.com",
+        "auth_username": "admin"
+      }
+    },
+    {
+      "metadata": { "guid": "broker-2-guid" },
+      "entity": {
+        "name": "broker-2",
+        "broker_url": "http://broker-2.example.com",
+        "auth_username": "admin"
+      }
+    }
+  ]
+}`},
+)
+
+func TestServiceBrokersFindAll(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(serviceBrokersEndpoint))
+	defer ts.Close()
+
+	repo := createServiceBrokerRepo(ts.URL)
+
+	brokers, err := repo.FindAll()
+	assert.NoError(t, err)
+	assert.Equal(t, len(brokers), 2)
+	assert.Equal(t, brokers[0], cf.ServiceBroker{
+		Name:     "broker-1",
+		Guid:     "broker-1-guid",
+		Url:      "http://broker-1.example.com",
+		Username: "admin",
+	})
+	assert.Equal(t, brokers[1].Name, "broker-2")
+}
+
+var findServiceBrokerByNameEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/service_brokers?q=name%3Amy-broker",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    {
+      "metadata": { "guid": "my-broker-guid" },
+      "entity": {
+        "name": "my-broker",
+        "broker_url": "http://broker.example.com",
+        "auth_username": "admin"
+      }
+    }
+  ]
+}`},
+)
+
+func TestServiceBrokersFindByName(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findServiceBrokerByNameEndpoint))
+	defer ts.Close()
+
+	repo := createServiceBrokerRepo(ts.URL)
+
+	broker, err := repo.FindByName("my-broker")
+	assert.NoError(t, err)
+	assert.Equal(t, broker.Guid, "my-broker-guid")
+	assert.Equal(t, broker.Url, "http://broker.example.com")
+}
+
+var findServiceBrokerByNameNotFoundEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/service_brokers?q=name%3Amy-broker",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `{ "resources": [] }`},
+)
+
+func TestServiceBrokersFindByNameWhenNotFound(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findServiceBrokerByNameNotFoundEndpoint))
+	defer ts.Close()
+
+	repo := createServiceBrokerRepo(ts.URL)
+
+	_, err := repo.FindByName("my-broker")
+	assert.Equal(t, err.Error(), "Service broker my-broker not found")
+}
+
+var createServiceBrokerEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/service_brokers",
+	testhelpers.RequestBodyMatcher(`{"name":"my-broker","broker_url":"http://broker.example.com","auth_username":"admin","auth_password":"secret"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestServiceBrokersCreate(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(createServiceBrokerEndpoint))
+	defer ts.Close()
+
+	repo := createServiceBrokerRepo(ts.URL)
+
+	err := repo.Create(cf.ServiceBroker{
+		Name:     "my-broker",
+		Url:      "http://broker.example.com",
+		Username: "admin",
+		Password: "secret",
+	})
+	assert.NoError(t, err)
+}
+
+var updateServiceBrokerEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/service_brokers/my-broker-guid",
+	testhelpers.RequestBodyMatcher(`{"broker_url":"http://new.example.com","auth_username":"root","auth_password":"new-secret"}`),
+	testhelpers.TestResponse{Status: http.StatusOK},
+)
+
+func TestServiceBrokersUpdate(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(updateServiceBrokerEndpoint))
+	defer ts.Close()
+
+	repo := createServiceBrokerRepo(ts.URL)
+
+	err := repo.Update(cf.ServiceBroker{
+		Name:     "my-broker",
+		Guid:     "my-broker-guid",
+		Url:      "http://new.example.com",
+		Username: "root",
+		Password: "new-secret",
+	})
+	assert.NoError(t, err)
+}
+
+var renameServiceBrokerEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/service_brokers/my-broker-guid",
+	testhelpers.RequestBodyMatcher(`{"name":"new-name"}`),
+	testhelpers.TestResponse{Status: http.StatusOK},
+)
+
+func TestServiceBrokersRename(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(renameServiceBrokerEndpoint))
+	defer ts.Close()
+
+	repo := createServiceBrokerRepo(ts.URL)
+
+	err := repo.Rename(cf.ServiceBroker{Name: "my-broker", Guid: "my-broker-guid"}, "new-name")
+	assert.NoError(t, err)
+}
+
+var deleteServiceBrokerEndpoint = testhelpers.CreateEndpoint(
+	"DELETE",
+	"/v2/service_brokers/my-broker-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusNoContent},
+)
+
+func TestServiceBrokersDelete(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(deleteServiceBrokerEndpoint))
+	defer ts.Close()
+
+	repo := createServiceBrokerRepo(ts.URL)
+
+	err := repo.Delete(cf.ServiceBroker{Name: "my-broker", Guid: "my-broker-guid"})
+	assert.NoError(t, err)
+}
+
+func createServiceBrokerRepo(target string) (repo ServiceBrokerRepository) {
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token", Target: target}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	return NewCloudControllerServiceBrokerRepository(config, client)
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"cf"
+	"cf/configuration"
+	"fmt"
+	"strings"
+)
+
+type ServicePlanVisibilityRepository interface {
+	FindAll() (visibilities []cf.ServicePlanVisibility, err error)
+	Create(plan cf.ServicePlan, org cf.Organization) (err error)
+	Delete(visibility cf.ServicePlanVisibility) (err error)
+}
+
+type CloudControllerServicePlanVisibilityRepository struct {
+	config    *configuration.Configuration
+	apiClient ApiClient
+}
+
+func NewCloudControllerServicePlanVisibilityRepository(config *configuration.Configuration, apiClient ApiClient) (repo CloudControllerServicePlanVisibilityRepository) {
+	repo.config = config
+	repo.apiClient = apiClient
+	return
+}
+
+func (repo CloudControllerServicePlanVisibilityRepository) FindAll() (visibilities []cf.ServicePlanVisibility, err error) {
+	path := fmt.Sprintf("%s/v2/service_plan_visibilities", repo.config.Target)
+	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	response := new(ServicePlanVisibilitiesApiResponse)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, response)
+	if err != nil {
+		return
+	}
+
+	for _, r := range response.Resources {
+		visibilities = append(visibilities, cf.ServicePlanVisibility{
+			Guid:             r.Metadata.Guid,
+			ServicePlanGuid:  r.Entity.ServicePlanGuid,
+			OrganizationGuid: r.Entity.OrganizationGuid,
+		})
+	}
+	return
+}
+
+func (repo CloudControllerServicePlanVisibilityRepository) Create(plan cf.ServicePlan, org cf.Organization) (err error) {
+	path := fmt.Sprintf("%s/v2/service_plan_visibilities", repo.config.Target)
+	data := fmt.Sprintf(`{"service_plan_guid":"%s","organization_guid":"%s"}`, plan.Guid, org.Guid)
+	request, err := NewRequest("POST", path, repo.config.AccessToken, strings.NewReader(data))
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func (repo CloudControllerServicePlanVisibilityRepository) Delete(visibility cf.ServicePlanVisibility) (err error) {
+	path := fmt.Sprintf("%s/v2/service_plan_visibilities/%s", repo.config.Target, visibility.Guid)
+	request, err := NewRequest("DELETE", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
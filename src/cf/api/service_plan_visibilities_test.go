@@ -0,0 +1,94 @@
+package api_test
+
+import (
+	"cf"
+	. "cf/api"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testhelpers"
+	"testing"
+)
+
+var servicePlanVisibilitiesEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/service_plan_visibilities",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    {
+      "metadata": { "guid": "visibility-1-guid" },
+      "entity": {
+        "service_plan_guid": "plan-1-guid",
+        "organization_guid": "org-1-guid"
+      }
+    },
+    {
+      "metadata": { "guid": "visibility-2-guid" },
+      "entity": {
+        "service_plan_guid": "plan-1-guid",
+        "organization_guid": "org-2-guid"
+      }
+    }
+  ]
+}`},
+)
+
+func TestServicePlanVisibilitiesFindAll(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(servicePlanVisibilitiesEndpoint))
+	defer ts.Close()
+
+	repo := createServicePlanVisibilityRepo(ts.URL)
+
+	visibilities, err := repo.FindAll()
+	assert.NoError(t, err)
+	assert.Equal(t, len(visibilities), 2)
+	assert.Equal(t, visibilities[0], cf.ServicePlanVisibility{
+		Guid:             "visibility-1-guid",
+		ServicePlanGuid:  "plan-1-guid",
+		OrganizationGuid: "org-1-guid",
+	})
+	assert.Equal(t, visibilities[1].OrganizationGuid, "org-2-guid")
+}
+
+var createServicePlanVisibilityEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/service_plan_visibilities",
+	testhelpers.RequestBodyMatcher(`{"service_plan_guid":"plan-guid","organization_guid":"org-guid"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestServicePlanVisibilitiesCreate(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(createServicePlanVisibilityEndpoint))
+	defer ts.Close()
+
+	repo := createServicePlanVisibilityRepo(ts.URL)
+
+	err := repo.Create(cf.ServicePlan{Guid: "plan-guid"}, cf.Organization{Guid: "org-guid"})
+	assert.NoError(t, err)
+}
+
+var deleteServicePlanVisibilityEndpoint = testhelpers.CreateEndpoint(
+	"DELETE",
+	"/v2/service_plan_visibilities/visibility-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusNoContent},
+)
+
+func TestServicePlanVisibilitiesDelete(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(deleteServicePlanVisibilityEndpoint))
+	defer ts.Close()
+
+	repo := createServicePlanVisibilityRepo(ts.URL)
+
+	err := repo.Delete(cf.ServicePlanVisibility{Guid: "visibility-guid"})
+	assert.NoError(t, err)
+}
+
+func createServicePlanVisibilityRepo(target string) (repo ServicePlanVisibilityRepository) {
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token", Target: target}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	return NewCloudControllerServicePlanVisibilityRepository(config, client)
+}
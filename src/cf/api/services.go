@@ -7,17 +7,28 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 )
 
 type ServiceRepository interface {
 	GetServiceOfferings() (offerings []cf.ServiceOffering, err error)
-	CreateServiceInstance(name string, plan cf.ServicePlan) (err error)
-	CreateUserProvidedServiceInstance(name string, params map[string]string) (err error)
+	GetServicePlans(offering cf.ServiceOffering) (plans []cf.ServicePlan, err error)
+	SetServicePlanPublic(plan cf.ServicePlan, public bool) (err error)
+	CreateServiceInstance(name string, plan cf.ServicePlan, params map[string]interface{}) (instance cf.ServiceInstance, err error)
+	CreateUserProvidedServiceInstance(instance cf.ServiceInstance) (err error)
+	UpdateUserProvidedServiceInstance(instance cf.ServiceInstance, update UserProvidedServiceUpdate) (err error)
+	UpdateServiceInstance(instance cf.ServiceInstance, plan cf.ServicePlan, params map[string]interface{}) (err error)
 	FindInstanceByName(name string) (instance cf.ServiceInstance, err error)
-	BindService(instance cf.ServiceInstance, app cf.Application) (errorCode int, err error)
+	GetServiceInstance(guid string) (instance cf.ServiceInstance, errorCode int, err error)
+	BindService(instance cf.ServiceInstance, app cf.Application, params map[string]interface{}) (errorCode int, err error)
 	UnbindService(instance cf.ServiceInstance, app cf.Application) (err error)
 	DeleteService(instance cf.ServiceInstance) (err error)
+	RenameService(instance cf.ServiceInstance, newName string) (err error)
+	CreateServiceKey(instance cf.ServiceInstance, keyName string) (errorCode int, err error)
+	GetServiceKeys(instance cf.ServiceInstance) (keys []cf.ServiceKey, err error)
+	FindServiceKey(instance cf.ServiceInstance, keyName string) (key cf.ServiceKey, err error)
+	DeleteServiceKey(key cf.ServiceKey) (err error)
 }
 
 type CloudControllerServiceRepository struct {
@@ -47,31 +58,54 @@ func (repo CloudControllerServiceRepository) GetServiceOfferings() (offerings []
 	}
 
 	for _, r := range response.Resources {
-		plans := []cf.ServicePlan{}
+		offering := newServiceOfferingFromResource(r)
+		offering.Plans = []cf.ServicePlan{}
 		for _, p := range r.Entity.ServicePlans {
-			plans = append(plans, cf.ServicePlan{Name: p.Entity.Name, Guid: p.Metadata.Guid})
+			offering.Plans = append(offering.Plans, newServicePlanFromResource(p))
 		}
-		offerings = append(offerings, cf.ServiceOffering{
-			Label:       r.Entity.Label,
-			Version:     r.Entity.Version,
-			Provider:    r.Entity.Provider,
-			Description: r.Entity.Description,
-			Guid:        r.Metadata.Guid,
-			Plans:       plans,
-		})
+		offerings = append(offerings, offering)
 	}
 
 	return
 }
 
-func (repo CloudControllerServiceRepository) CreateServiceInstance(name string, plan cf.ServicePlan) (err error) {
-	path := fmt.Sprintf("%s/v2/service_instances", repo.config.Target)
+func (repo CloudControllerServiceRepository) GetServicePlans(offering cf.ServiceOffering) (plans []cf.ServicePlan, err error) {
+	path := fmt.Sprintf("%s/v2/services/%s/service_plans", repo.config.Target, offering.Guid)
+	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
 
-	data := fmt.Sprintf(
-		`{"name":"%s","service_plan_guid":"%s","space_guid":"%s"}`,
-		name, plan.Guid, repo.config.Space.Guid,
-	)
-	request, err := NewRequest("POST", path, repo.config.AccessToken, strings.NewReader(data))
+	response := new(ServicePlansApiResponse)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, response)
+	if err != nil {
+		return
+	}
+
+	for _, r := range response.Resources {
+		plan := newServicePlanFromResource(r)
+		plan.ServiceOffering = offering
+		plans = append(plans, plan)
+	}
+	return
+}
+
+func newServiceOfferingFromResource(r ServiceOfferingResource) cf.ServiceOffering {
+	return cf.ServiceOffering{
+		Label:       r.Entity.Label,
+		Version:     r.Entity.Version,
+		Provider:    r.Entity.Provider,
+		Description: r.Entity.Description,
+		Guid:        r.Metadata.Guid,
+	}
+}
+
+// A public plan is visible to every org, a private one only to the orgs
+// given access through a service plan visibility.
+func (repo CloudControllerServiceRepository) SetServicePlanPublic(plan cf.ServicePlan, public bool) (err error) {
+	path := fmt.Sprintf("%s/v2/service_plans/%s", repo.config.Target, plan.Guid)
+	data := fmt.Sprintf(`{"public":%t}`, public)
+	request, err := NewRequest("PUT", path, repo.config.AccessToken, strings.NewReader(data))
 	if err != nil {
 		return
 	}
@@ -80,16 +114,74 @@ func (repo CloudControllerServiceRepository) CreateServiceInstance(name string,
 	return
 }
 
-func (repo CloudControllerServiceRepository) CreateUserProvidedServiceInstance(name string, params map[string]string) (err error) {
+func newServicePlanFromResource(r ServicePlanResource) (plan cf.ServicePlan) {
+	plan.Name = r.Entity.Name
+	plan.Guid = r.Metadata.Guid
+	plan.Description = r.Entity.Description
+	plan.Free = r.Entity.Free
+	plan.Public = r.Entity.Public
+	plan.ServiceOffering = newServiceOfferingFromResource(r.Entity.ServiceOffering)
+
+	// Brokers are free to put anything in "extra", so a plan whose costs
+	// cannot be read is simply shown without them.
+	extra := ServicePlanExtra{}
+	if json.Unmarshal([]byte(r.Entity.Extra), &extra) == nil {
+		for _, cost := range extra.Costs {
+			plan.Costs = append(plan.Costs, cf.ServicePlanCost{Amount: cost.Amount, Unit: cost.Unit})
+		}
+	}
+	return
+}
+
+// Creating, updating and deleting service instances all accept incomplete
+// operations, so that brokers may finish them asynchronously. Callers follow
+// the instance's last_operation to find out when they are done.
+//
+// The params of these requests are broker specific configuration, which is
+// left out of the request body when there is none.
+func (repo CloudControllerServiceRepository) CreateServiceInstance(name string, plan cf.ServicePlan, params map[string]interface{}) (instance cf.ServiceInstance, err error) {
+	path := fmt.Sprintf("%s/v2/service_instances?accepts_incomplete=true", repo.config.Target)
+
+	type RequestBody struct {
+		Name            string                 `json:"name"`
+		ServicePlanGuid string                 `json:"service_plan_guid"`
+		SpaceGuid       string                 `json:"space_guid"`
+		Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	}
+
+	reqBody := RequestBody{name, plan.Guid, repo.config.Space.Guid, params}
+	jsonBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("POST", path, repo.config.AccessToken, bytes.NewReader(jsonBytes))
+	if err != nil {
+		return
+	}
+
+	resource := new(ServiceInstanceResource)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, resource)
+	if err != nil {
+		return
+	}
+
+	instance = newServiceInstanceFromResource(*resource)
+	return
+}
+
+func (repo CloudControllerServiceRepository) CreateUserProvidedServiceInstance(instance cf.ServiceInstance) (err error) {
 	path := fmt.Sprintf("%s/v2/user_provided_service_instances", repo.config.Target)
 
 	type RequestBody struct {
-		Name        string            `json:"name"`
-		Credentials map[string]string `json:"credentials"`
-		SpaceGuid   string            `json:"space_guid"`
+		Name            string                 `json:"name"`
+		Credentials     map[string]interface{} `json:"credentials"`
+		SpaceGuid       string                 `json:"space_guid"`
+		SyslogDrainUrl  string                 `json:"syslog_drain_url,omitempty"`
+		RouteServiceUrl string                 `json:"route_service_url,omitempty"`
 	}
 
-	reqBody := RequestBody{name, params, repo.config.Space.Guid}
+	reqBody := RequestBody{instance.Name, instance.Credentials, repo.config.Space.Guid, instance.SyslogDrainUrl, instance.RouteServiceUrl}
 	jsonBytes, err := json.Marshal(reqBody)
 	if err != nil {
 		return
@@ -104,8 +196,57 @@ func (repo CloudControllerServiceRepository) CreateUserProvidedServiceInstance(n
 	return
 }
 
+// UserProvidedServiceUpdate holds what to change on a user provided service
+// instance. Nil urls are left as they are, empty ones are removed.
+type UserProvidedServiceUpdate struct {
+	Credentials     map[string]interface{} `json:"credentials,omitempty"`
+	SyslogDrainUrl  *string                `json:"syslog_drain_url,omitempty"`
+	RouteServiceUrl *string                `json:"route_service_url,omitempty"`
+}
+
+func (repo CloudControllerServiceRepository) UpdateUserProvidedServiceInstance(instance cf.ServiceInstance, update UserProvidedServiceUpdate) (err error) {
+	path := fmt.Sprintf("%s/v2/user_provided_service_instances/%s", repo.config.Target, instance.Guid)
+
+	jsonBytes, err := json.Marshal(update)
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("PUT", path, repo.config.AccessToken, bytes.NewReader(jsonBytes))
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+// An empty plan leaves the plan of the instance as it is.
+func (repo CloudControllerServiceRepository) UpdateServiceInstance(instance cf.ServiceInstance, plan cf.ServicePlan, params map[string]interface{}) (err error) {
+	path := fmt.Sprintf("%s/v2/service_instances/%s?accepts_incomplete=true", repo.config.Target, instance.Guid)
+
+	type RequestBody struct {
+		ServicePlanGuid string                 `json:"service_plan_guid,omitempty"`
+		Parameters      map[string]interface{} `json:"parameters,omitempty"`
+	}
+
+	reqBody := RequestBody{plan.Guid, params}
+	jsonBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("PUT", path, repo.config.AccessToken, bytes.NewReader(jsonBytes))
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
 func (repo CloudControllerServiceRepository) FindInstanceByName(name string) (instance cf.ServiceInstance, err error) {
-	path := fmt.Sprintf("%s/v2/spaces/%s/service_instances?return_user_provided_service_instances=true&q=name%s&inline-relations-depth=1", repo.config.Target, repo.config.Space.Guid, "%3A"+name)
+	path := fmt.Sprintf("%s/v2/spaces/%s/service_instances?return_user_provided_service_instances=true&q=name%s&inline-relations-depth=2", repo.config.Target, repo.config.Space.Guid, "%3A"+name)
 	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
 	if err != nil {
 		return
@@ -123,29 +264,76 @@ func (repo CloudControllerServiceRepository) FindInstanceByName(name string) (in
 	}
 
 	resource := response.Resources[0]
-	instance.Guid = resource.Metadata.Guid
-	instance.Name = resource.Entity.Name
+	instance = newServiceInstanceFromResource(resource)
 	instance.ServiceBindings = []cf.ServiceBinding{}
 
 	for _, bindingResource := range resource.Entity.ServiceBindings {
 		newBinding := cf.ServiceBinding{
-			Url:     bindingResource.Metadata.Url,
-			Guid:    bindingResource.Metadata.Guid,
-			AppGuid: bindingResource.Entity.AppGuid,
+			Url:      bindingResource.Metadata.Url,
+			Guid:     bindingResource.Metadata.Guid,
+			AppGuid:  bindingResource.Entity.AppGuid,
+			AppName:  bindingResource.Entity.App.Entity.Name,
+			AppState: strings.ToLower(bindingResource.Entity.App.Entity.State),
 		}
 		instance.ServiceBindings = append(instance.ServiceBindings, newBinding)
+
+		if bindingResource.Entity.App.Entity.Name != "" {
+			instance.ApplicationNames = append(instance.ApplicationNames, bindingResource.Entity.App.Entity.Name)
+		}
+	}
+
+	return
+}
+
+func (repo CloudControllerServiceRepository) GetServiceInstance(guid string) (instance cf.ServiceInstance, errorCode int, err error) {
+	path := fmt.Sprintf("%s/v2/service_instances/%s", repo.config.Target, guid)
+	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	resource := new(ServiceInstanceResource)
+	errorCode, err = repo.apiClient.PerformRequestAndParseResponse(request, resource)
+	if err != nil {
+		return
 	}
 
+	instance = newServiceInstanceFromResource(*resource)
 	return
 }
 
-func (repo CloudControllerServiceRepository) BindService(instance cf.ServiceInstance, app cf.Application) (errorCode int, err error) {
+func newServiceInstanceFromResource(r ServiceInstanceResource) (instance cf.ServiceInstance) {
+	instance.Guid = r.Metadata.Guid
+	instance.Name = r.Entity.Name
+	instance.DashboardUrl = r.Entity.DashboardUrl
+	instance.LastOperation = cf.LastOperation{
+		Type:        r.Entity.LastOperation.Type,
+		State:       r.Entity.LastOperation.State,
+		Description: r.Entity.LastOperation.Description,
+	}
+
+	if r.Entity.ServicePlan.Metadata.Guid != "" {
+		instance.ServicePlan = newServicePlanFromResource(r.Entity.ServicePlan)
+	}
+	return
+}
+
+func (repo CloudControllerServiceRepository) BindService(instance cf.ServiceInstance, app cf.Application, params map[string]interface{}) (errorCode int, err error) {
 	path := fmt.Sprintf("%s/v2/service_bindings", repo.config.Target)
-	body := fmt.Sprintf(
-		`{"app_guid":"%s","service_instance_guid":"%s"}`,
-		app.Guid, instance.Guid,
-	)
-	request, err := NewRequest("POST", path, repo.config.AccessToken, strings.NewReader(body))
+
+	type RequestBody struct {
+		AppGuid             string                 `json:"app_guid"`
+		ServiceInstanceGuid string                 `json:"service_instance_guid"`
+		Parameters          map[string]interface{} `json:"parameters,omitempty"`
+	}
+
+	reqBody := RequestBody{app.Guid, instance.Guid, params}
+	jsonBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("POST", path, repo.config.AccessToken, bytes.NewReader(jsonBytes))
 	if err != nil {
 		return
 	}
@@ -183,7 +371,110 @@ func (repo CloudControllerServiceRepository) DeleteService(instance cf.ServiceIn
 		return errors.New("Cannot delete service instance, apps are still bound to it")
 	}
 
+	path := fmt.Sprintf("%s/v2/service_instances/%s?accepts_incomplete=true", repo.config.Target, instance.Guid)
+	request, err := NewRequest("DELETE", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func (repo CloudControllerServiceRepository) RenameService(instance cf.ServiceInstance, newName string) (err error) {
+	err = validateName("Service instance", newName)
+	if err != nil {
+		return
+	}
+
 	path := fmt.Sprintf("%s/v2/service_instances/%s", repo.config.Target, instance.Guid)
+	if instance.IsUserProvided() {
+		path = fmt.Sprintf("%s/v2/user_provided_service_instances/%s", repo.config.Target, instance.Guid)
+	}
+
+	data, err := json.Marshal(map[string]string{"name": newName})
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("PUT", path, repo.config.AccessToken, bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func (repo CloudControllerServiceRepository) CreateServiceKey(instance cf.ServiceInstance, keyName string) (errorCode int, err error) {
+	path := fmt.Sprintf("%s/v2/service_keys", repo.config.Target)
+
+	type RequestBody struct {
+		ServiceInstanceGuid string `json:"service_instance_guid"`
+		Name                string `json:"name"`
+	}
+
+	reqBody := RequestBody{instance.Guid, keyName}
+	jsonBytes, err := json.Marshal(reqBody)
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("POST", path, repo.config.AccessToken, bytes.NewReader(jsonBytes))
+	if err != nil {
+		return
+	}
+
+	errorCode, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func (repo CloudControllerServiceRepository) GetServiceKeys(instance cf.ServiceInstance) (keys []cf.ServiceKey, err error) {
+	path := fmt.Sprintf("%s/v2/service_instances/%s/service_keys", repo.config.Target, instance.Guid)
+	return repo.findServiceKeys(path)
+}
+
+func (repo CloudControllerServiceRepository) FindServiceKey(instance cf.ServiceInstance, keyName string) (key cf.ServiceKey, err error) {
+	path := fmt.Sprintf("%s/v2/service_instances/%s/service_keys?q=name%s", repo.config.Target, instance.Guid, "%3A"+url.QueryEscape(keyName))
+	keys, err := repo.findServiceKeys(path)
+	if err != nil {
+		return
+	}
+
+	if len(keys) == 0 {
+		err = errors.New(fmt.Sprintf("Service key %s not found for service %s", keyName, instance.Name))
+		return
+	}
+
+	key = keys[0]
+	return
+}
+
+func (repo CloudControllerServiceRepository) findServiceKeys(path string) (keys []cf.ServiceKey, err error) {
+	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	response := new(ServiceKeysApiResponse)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, response)
+	if err != nil {
+		return
+	}
+
+	for _, r := range response.Resources {
+		keys = append(keys, cf.ServiceKey{
+			Name:                r.Entity.Name,
+			Guid:                r.Metadata.Guid,
+			ServiceInstanceGuid: r.Entity.ServiceInstanceGuid,
+			Credentials:         r.Entity.Credentials,
+		})
+	}
+	return
+}
+
+func (repo CloudControllerServiceRepository) DeleteServiceKey(key cf.ServiceKey) (err error) {
+	path := fmt.Sprintf("%s/v2/service_keys/%s", repo.config.Target, key.Guid)
 	request, err := NewRequest("DELETE", path, repo.config.AccessToken, nil)
 	if err != nil {
 		return
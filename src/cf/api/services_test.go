@@ -26,7 +26,7 @@ var multipleOfferingsResponse = testhelpers.TestResponse{Status: http.StatusOK,
         "service_plans": [
         	{
         		"metadata": {"guid": "offering-1-plan-1-guid"},
-        		"entity": {"name": "Offering 1 Plan 1"}
+        		"entity": {"name": "Offering 1 Plan 1", "public": true}
         	},
         	{
         		"metadata": {"guid": "offering-1-plan-2-guid"},
@@ -88,6 +88,8 @@ func TestGetServiceOfferings(t *testing.T) {
 	plan := firstOffering.Plans[0]
 	assert.Equal(t, plan.Name, "Offering 1 Plan 1")
 	assert.Equal(t, plan.Guid, "offering-1-plan-1-guid")
+	assert.True(t, plan.Public)
+	assert.False(t, firstOffering.Plans[1].Public)
 
 	secondOffering := offerings[1]
 	assert.Equal(t, secondOffering.Label, "Offering 2")
@@ -97,9 +99,16 @@ func TestGetServiceOfferings(t *testing.T) {
 
 var createServiceInstanceEndpoint = testhelpers.CreateEndpoint(
 	"POST",
-	"/v2/service_instances",
+	"/v2/service_instances?accepts_incomplete=true",
 	testhelpers.RequestBodyMatcher(`{"name":"instance-name","service_plan_guid":"plan-guid","space_guid":"space-guid"}`),
-	testhelpers.TestResponse{Status: http.StatusCreated},
+	testhelpers.TestResponse{Status: http.StatusAccepted, Body: `
+{
+  "metadata": { "guid": "instance-guid" },
+  "entity": {
+    "name": "instance-name",
+    "last_operation": { "type": "create", "state": "in progress" }
+  }
+}`},
 )
 
 func TestCreateServiceInstance(t *testing.T) {
@@ -114,8 +123,97 @@ func TestCreateServiceInstance(t *testing.T) {
 	client := NewApiClient(&testhelpers.FakeAuthenticator{})
 	repo := NewCloudControllerServiceRepository(config, client)
 
-	err := repo.CreateServiceInstance("instance-name", cf.ServicePlan{Guid: "plan-guid"})
+	instance, err := repo.CreateServiceInstance("instance-name", cf.ServicePlan{Guid: "plan-guid"}, nil)
 	assert.NoError(t, err)
+	assert.Equal(t, instance.Guid, "instance-guid")
+	assert.Equal(t, instance.Name, "instance-name")
+	assert.True(t, instance.LastOperation.InProgress())
+}
+
+var createServiceInstanceWithParametersEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/service_instances?accepts_incomplete=true",
+	testhelpers.RequestBodyMatcher(`{"name":"instance-name","service_plan_guid":"plan-guid","space_guid":"space-guid","parameters":{"nodes":3,"region":"eu"}}`),
+	testhelpers.TestResponse{Status: http.StatusCreated, Body: `
+{
+  "metadata": { "guid": "instance-guid" },
+  "entity": { "name": "instance-name" }
+}`},
+)
+
+func TestCreateServiceInstanceWithParameters(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(createServiceInstanceWithParametersEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+		Space:       cf.Space{Guid: "space-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	params := map[string]interface{}{"region": "eu", "nodes": 3}
+	instance, err := repo.CreateServiceInstance("instance-name", cf.ServicePlan{Guid: "plan-guid"}, params)
+	assert.NoError(t, err)
+	assert.Equal(t, instance.Guid, "instance-guid")
+}
+
+var getServiceInstanceEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/service_instances/instance-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "metadata": { "guid": "instance-guid" },
+  "entity": {
+    "name": "instance-name",
+    "last_operation": { "type": "update", "state": "in progress", "description": "50% done" }
+  }
+}`},
+)
+
+func TestGetServiceInstance(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(getServiceInstanceEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	instance, _, err := repo.GetServiceInstance("instance-guid")
+	assert.NoError(t, err)
+	assert.Equal(t, instance.Name, "instance-name")
+	assert.Equal(t, instance.LastOperation, cf.LastOperation{Type: "update", State: "in progress", Description: "50% done"})
+}
+
+var getMissingServiceInstanceEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/service_instances/instance-guid",
+	nil,
+	testhelpers.TestResponse{
+		Status: http.StatusNotFound,
+		Body:   `{"code":60004,"description":"The service instance could not be found: instance-guid"}`,
+	},
+)
+
+func TestGetServiceInstanceWhenNotFound(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(getMissingServiceInstanceEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	_, errorCode, err := repo.GetServiceInstance("instance-guid")
+	assert.Error(t, err)
+	assert.Equal(t, errorCode, 60004)
 }
 
 var createUserProvidedServiceInstanceEndpoint = testhelpers.CreateEndpoint(
@@ -137,12 +235,91 @@ func TestCreateUserProvidedServiceInstance(t *testing.T) {
 	client := NewApiClient(&testhelpers.FakeAuthenticator{})
 	repo := NewCloudControllerServiceRepository(config, client)
 
-	params := map[string]string{
+	params := map[string]interface{}{
 		"host":     "example.com",
 		"user":     "me",
 		"password": "secret",
 	}
-	err := repo.CreateUserProvidedServiceInstance("my-custom-service", params)
+	err := repo.CreateUserProvidedServiceInstance(cf.ServiceInstance{Name: "my-custom-service", Credentials: params})
+	assert.NoError(t, err)
+}
+
+var createUserProvidedServiceInstanceWithUrlsEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/user_provided_service_instances",
+	testhelpers.RequestBodyMatcher(`{"name":"my-custom-service","credentials":{"db":{"host":"example.com","port":5432}},"space_guid":"some-space-guid","syslog_drain_url":"syslog://logs.example.com","route_service_url":"https://proxy.example.com"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestCreateUserProvidedServiceInstanceWithNestedCredentialsAndUrls(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(createUserProvidedServiceInstanceWithUrlsEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+		Space:       cf.Space{Guid: "some-space-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	instance := cf.ServiceInstance{
+		Name: "my-custom-service",
+		Credentials: map[string]interface{}{
+			"db": map[string]interface{}{"host": "example.com", "port": 5432},
+		},
+		SyslogDrainUrl:  "syslog://logs.example.com",
+		RouteServiceUrl: "https://proxy.example.com",
+	}
+	err := repo.CreateUserProvidedServiceInstance(instance)
+	assert.NoError(t, err)
+}
+
+var updateUserProvidedServiceInstanceEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/user_provided_service_instances/my-custom-service-guid",
+	testhelpers.RequestBodyMatcher(`{"credentials":{"password":"rotated"}}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestUpdateUserProvidedServiceInstance(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(updateUserProvidedServiceInstanceEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	instance := cf.ServiceInstance{Guid: "my-custom-service-guid"}
+	update := UserProvidedServiceUpdate{Credentials: map[string]interface{}{"password": "rotated"}}
+	err := repo.UpdateUserProvidedServiceInstance(instance, update)
+	assert.NoError(t, err)
+}
+
+var clearUserProvidedServiceInstanceUrlEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/user_provided_service_instances/my-custom-service-guid",
+	testhelpers.RequestBodyMatcher(`{"syslog_drain_url":""}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestUpdateUserProvidedServiceInstanceClearsUrls(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(clearUserProvidedServiceInstanceUrlEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	noUrl := ""
+	instance := cf.ServiceInstance{Guid: "my-custom-service-guid"}
+	err := repo.UpdateUserProvidedServiceInstance(instance, UserProvidedServiceUpdate{SyslogDrainUrl: &noUrl})
 	assert.NoError(t, err)
 }
 
@@ -161,7 +338,11 @@ var singleServiceInstanceResponse = testhelpers.TestResponse{Status: http.Status
               "url": "/v2/service_bindings/service-binding-1-guid"
             },
             "entity": {
-              "app_guid": "app-1-guid"
+              "app_guid": "app-1-guid",
+              "app": {
+                "metadata": { "guid": "app-1-guid" },
+                "entity": { "name": "app-1", "state": "STARTED" }
+              }
             }
           },
           {
@@ -208,6 +389,9 @@ func TestFindInstanceByName(t *testing.T) {
 	assert.Equal(t, binding.Url, "/v2/service_bindings/service-binding-1-guid")
 	assert.Equal(t, binding.Guid, "service-binding-1-guid")
 	assert.Equal(t, binding.AppGuid, "app-1-guid")
+	assert.Equal(t, binding.AppName, "app-1")
+	assert.Equal(t, binding.AppState, "started")
+	assert.Equal(t, instance.ApplicationNames, []string{"app-1"})
 }
 
 var bindServiceEndpoint = testhelpers.CreateEndpoint(
@@ -230,7 +414,31 @@ func TestBindService(t *testing.T) {
 
 	serviceInstance := cf.ServiceInstance{Guid: "my-service-instance-guid"}
 	app := cf.Application{Guid: "my-app-guid"}
-	_, err := repo.BindService(serviceInstance, app)
+	_, err := repo.BindService(serviceInstance, app, nil)
+	assert.NoError(t, err)
+}
+
+var bindServiceWithParametersEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/service_bindings",
+	testhelpers.RequestBodyMatcher(`{"app_guid":"my-app-guid","service_instance_guid":"my-service-instance-guid","parameters":{"role":"read-only"}}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestBindServiceWithParameters(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(bindServiceWithParametersEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	serviceInstance := cf.ServiceInstance{Guid: "my-service-instance-guid"}
+	app := cf.Application{Guid: "my-app-guid"}
+	_, err := repo.BindService(serviceInstance, app, map[string]interface{}{"role": "read-only"})
 	assert.NoError(t, err)
 }
 
@@ -257,12 +465,87 @@ func TestBindServiceIfError(t *testing.T) {
 
 	serviceInstance := cf.ServiceInstance{Guid: "my-service-instance-guid"}
 	app := cf.Application{Guid: "my-app-guid"}
-	errorCode, err := repo.BindService(serviceInstance, app)
+	errorCode, err := repo.BindService(serviceInstance, app, nil)
 
 	assert.Error(t, err)
 	assert.Equal(t, errorCode, 90003)
 }
 
+var updateServiceInstanceEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/service_instances/my-service-instance-guid?accepts_incomplete=true",
+	testhelpers.RequestBodyMatcher(`{"service_plan_guid":"new-plan-guid"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestUpdateServiceInstance(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(updateServiceInstanceEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	serviceInstance := cf.ServiceInstance{Guid: "my-service-instance-guid"}
+	plan := cf.ServicePlan{Guid: "new-plan-guid"}
+	err := repo.UpdateServiceInstance(serviceInstance, plan, nil)
+	assert.NoError(t, err)
+}
+
+var updateServiceInstanceParametersEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/service_instances/my-service-instance-guid?accepts_incomplete=true",
+	testhelpers.RequestBodyMatcher(`{"parameters":{"nodes":5}}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestUpdateServiceInstanceParametersOnly(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(updateServiceInstanceParametersEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	serviceInstance := cf.ServiceInstance{Guid: "my-service-instance-guid"}
+	err := repo.UpdateServiceInstance(serviceInstance, cf.ServicePlan{}, map[string]interface{}{"nodes": 5})
+	assert.NoError(t, err)
+}
+
+var updateServiceInstanceErrorEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/service_instances/my-service-instance-guid",
+	testhelpers.RequestBodyMatcher(`{"service_plan_guid":"new-plan-guid"}`),
+	testhelpers.TestResponse{
+		Status: http.StatusBadRequest,
+		Body:   `{"code":60015,"description":"The service does not support changing plans."}`,
+	},
+)
+
+func TestUpdateServiceInstanceIfError(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(updateServiceInstanceErrorEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	serviceInstance := cf.ServiceInstance{Guid: "my-service-instance-guid"}
+	plan := cf.ServicePlan{Guid: "new-plan-guid"}
+	err := repo.UpdateServiceInstance(serviceInstance, plan, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "The service does not support changing plans.")
+}
+
 var deleteBindingEndpoint = testhelpers.CreateEndpoint(
 	"DELETE",
 	"/v2/service_bindings/service-binding-2-guid",
@@ -297,7 +580,7 @@ func TestUnbindService(t *testing.T) {
 
 var deleteServiceInstanceEndpoint = testhelpers.CreateEndpoint(
 	"DELETE",
-	"/v2/service_instances/my-service-instance-guid",
+	"/v2/service_instances/my-service-instance-guid?accepts_incomplete=true",
 	nil,
 	testhelpers.TestResponse{Status: http.StatusOK},
 )
@@ -338,3 +621,374 @@ func TestDeleteServiceWithServiceBindings(t *testing.T) {
 	err := repo.DeleteService(serviceInstance)
 	assert.Equal(t, err.Error(), "Cannot delete service instance, apps are still bound to it")
 }
+
+var setServicePlanPublicEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/service_plans/my-plan-guid",
+	testhelpers.RequestBodyMatcher(`{"public":true}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestSetServicePlanPublic(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(setServicePlanPublicEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	err := repo.SetServicePlanPublic(cf.ServicePlan{Name: "my-plan", Guid: "my-plan-guid"}, true)
+	assert.NoError(t, err)
+}
+
+var renameServiceInstanceEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/service_instances/my-service-instance-guid",
+	testhelpers.RequestBodyMatcher(`{"name":"new-name"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestRenameService(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(renameServiceInstanceEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	serviceInstance := cf.ServiceInstance{
+		Guid:        "my-service-instance-guid",
+		ServicePlan: cf.ServicePlan{Guid: "plan-guid"},
+	}
+	err := repo.RenameService(serviceInstance, "new-name")
+	assert.NoError(t, err)
+}
+
+var renameUserProvidedServiceInstanceEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/user_provided_service_instances/my-service-instance-guid",
+	testhelpers.RequestBodyMatcher(`{"name":"new-name"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestRenameUserProvidedService(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(renameUserProvidedServiceInstanceEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	serviceInstance := cf.ServiceInstance{Guid: "my-service-instance-guid"}
+	err := repo.RenameService(serviceInstance, "new-name")
+	assert.NoError(t, err)
+}
+
+func TestRenameServiceRejectsInproperNames(t *testing.T) {
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token"}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	serviceInstance := cf.ServiceInstance{Guid: "my-service-instance-guid"}
+	err := repo.RenameService(serviceInstance, "new name")
+	assert.Contains(t, err.Error(), "Service instance name is invalid")
+}
+
+var findServiceInstanceWithDetailsEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/spaces/my-space-guid/service_instances?return_user_provided_service_instances=true&q=name%3Amy-service&inline-relations-depth=2",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `{
+  "resources": [
+    {
+      "metadata": { "guid": "my-service-instance-guid" },
+      "entity": {
+        "name": "my-service",
+        "dashboard_url": "https://dashboard.example.com/my-service",
+        "last_operation": {
+          "type": "create",
+          "state": "succeeded",
+          "description": "service ready"
+        },
+        "service_plan": {
+          "metadata": { "guid": "plan-guid" },
+          "entity": {
+            "name": "spark",
+            "description": "A small plan",
+            "free": true,
+            "service": {
+              "metadata": { "guid": "offering-guid" },
+              "entity": {
+                "label": "cleardb",
+                "provider": "cleardb-provider",
+                "version": "n/a",
+                "description": "A MySQL database"
+              }
+            }
+          }
+        },
+        "service_bindings": [
+          {
+            "metadata": {
+              "guid": "service-binding-1-guid",
+              "url": "/v2/service_bindings/service-binding-1-guid"
+            },
+            "entity": {
+              "app_guid": "app-1-guid",
+              "app": { "metadata": { "guid": "app-1-guid" }, "entity": { "name": "app-1" } }
+            }
+          }
+        ]
+      }
+    }
+  ]
+}`},
+)
+
+func TestFindInstanceByNameWithPlanAndBoundApps(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findServiceInstanceWithDetailsEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+		Space:       cf.Space{Guid: "my-space-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	instance, err := repo.FindInstanceByName("my-service")
+	assert.NoError(t, err)
+	assert.False(t, instance.IsUserProvided())
+	assert.Equal(t, instance.DashboardUrl, "https://dashboard.example.com/my-service")
+	assert.Equal(t, instance.LastOperation, cf.LastOperation{Type: "create", State: "succeeded", Description: "service ready"})
+	assert.Equal(t, instance.ServicePlan.Name, "spark")
+	assert.Equal(t, instance.ServicePlan.Guid, "plan-guid")
+	assert.True(t, instance.ServicePlan.Free)
+	assert.Equal(t, instance.ServicePlan.ServiceOffering.Label, "cleardb")
+	assert.Equal(t, instance.ServicePlan.ServiceOffering.Description, "A MySQL database")
+	assert.Equal(t, instance.ApplicationNames, []string{"app-1"})
+}
+
+var servicePlansEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/services/offering-guid/service_plans",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `{
+  "resources": [
+    {
+      "metadata": { "guid": "plan-1-guid" },
+      "entity": {
+        "name": "spark",
+        "description": "A small plan",
+        "free": true,
+        "extra": null
+      }
+    },
+    {
+      "metadata": { "guid": "plan-2-guid" },
+      "entity": {
+        "name": "boost",
+        "description": "A bigger plan",
+        "free": false,
+        "extra": "{\"costs\":[{\"amount\":{\"usd\":9.5},\"unit\":\"MONTHLY\"}]}"
+      }
+    },
+    {
+      "metadata": { "guid": "plan-3-guid" },
+      "entity": {
+        "name": "odd",
+        "free": false,
+        "extra": "not json"
+      }
+    }
+  ]
+}`},
+)
+
+func TestGetServicePlans(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(servicePlansEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token", Target: ts.URL}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	offering := cf.ServiceOffering{Label: "cleardb", Guid: "offering-guid"}
+	plans, err := repo.GetServicePlans(offering)
+	assert.NoError(t, err)
+	assert.Equal(t, len(plans), 3)
+
+	assert.Equal(t, plans[0].Name, "spark")
+	assert.Equal(t, plans[0].Guid, "plan-1-guid")
+	assert.Equal(t, plans[0].Description, "A small plan")
+	assert.True(t, plans[0].Free)
+	assert.Equal(t, len(plans[0].Costs), 0)
+	assert.Equal(t, plans[0].ServiceOffering, offering)
+
+	assert.False(t, plans[1].Free)
+	assert.Equal(t, plans[1].Costs, []cf.ServicePlanCost{
+		cf.ServicePlanCost{Amount: map[string]float64{"usd": 9.5}, Unit: "MONTHLY"},
+	})
+
+	assert.Equal(t, len(plans[2].Costs), 0)
+}
+
+var createServiceKeyEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/service_keys",
+	testhelpers.RequestBodyMatcher(`{"service_instance_guid":"my-service-instance-guid","name":"my-key"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestCreateServiceKey(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(createServiceKeyEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	_, err := repo.CreateServiceKey(cf.ServiceInstance{Guid: "my-service-instance-guid"}, "my-key")
+	assert.NoError(t, err)
+}
+
+var serviceKeysEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/service_instances/my-service-instance-guid/service_keys",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `{
+  "resources": [
+    {
+      "metadata": { "guid": "key-1-guid" },
+      "entity": {
+        "name": "key-1",
+        "service_instance_guid": "my-service-instance-guid",
+        "credentials": { "uri": "mysql://example.com", "tls": { "ca": "cert" } }
+      }
+    },
+    {
+      "metadata": { "guid": "key-2-guid" },
+      "entity": {
+        "name": "key-2",
+        "service_instance_guid": "my-service-instance-guid",
+        "credentials": {}
+      }
+    }
+  ]
+}`},
+)
+
+func TestGetServiceKeys(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(serviceKeysEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	keys, err := repo.GetServiceKeys(cf.ServiceInstance{Guid: "my-service-instance-guid"})
+	assert.NoError(t, err)
+	assert.Equal(t, len(keys), 2)
+	assert.Equal(t, keys[0], cf.ServiceKey{
+		Name:                "key-1",
+		Guid:                "key-1-guid",
+		ServiceInstanceGuid: "my-service-instance-guid",
+		Credentials: map[string]interface{}{
+			"uri": "mysql://example.com",
+			"tls": map[string]interface{}{"ca": "cert"},
+		},
+	})
+	assert.Equal(t, keys[1].Name, "key-2")
+}
+
+var findServiceKeyEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/service_instances/my-service-instance-guid/service_keys?q=name%3Amy-key",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `{
+  "resources": [
+    {
+      "metadata": { "guid": "my-key-guid" },
+      "entity": { "name": "my-key", "credentials": { "password": "secret" } }
+    }
+  ]
+}`},
+)
+
+func TestFindServiceKey(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findServiceKeyEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	key, err := repo.FindServiceKey(cf.ServiceInstance{Guid: "my-service-instance-guid"}, "my-key")
+	assert.NoError(t, err)
+	assert.Equal(t, key.Guid, "my-key-guid")
+	assert.Equal(t, key.Credentials, map[string]interface{}{"password": "secret"})
+}
+
+var findMissingServiceKeyEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/service_instances/my-service-instance-guid/service_keys?q=name%3Amy-key",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `{ "resources": [] }`},
+)
+
+func TestFindServiceKeyWhenNotFound(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(findMissingServiceKeyEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	_, err := repo.FindServiceKey(cf.ServiceInstance{Name: "my-service", Guid: "my-service-instance-guid"}, "my-key")
+	assert.Error(t, err)
+	assert.Equal(t, err.Error(), "Service key my-key not found for service my-service")
+}
+
+var deleteServiceKeyEndpoint = testhelpers.CreateEndpoint(
+	"DELETE",
+	"/v2/service_keys/my-key-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusNoContent},
+)
+
+func TestDeleteServiceKey(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(deleteServiceKeyEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerServiceRepository(config, client)
+
+	err := repo.DeleteServiceKey(cf.ServiceKey{Guid: "my-key-guid"})
+	assert.NoError(t, err)
+}
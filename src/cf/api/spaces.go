@@ -1,8 +1,10 @@
 package api
 
 import (
+	"bytes"
 	"cf"
 	"cf/configuration"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -13,6 +15,10 @@ type SpaceRepository interface {
 	FindAll() (spaces []cf.Space, err error)
 	FindByName(name string) (space cf.Space, err error)
 	GetSummary() (space cf.Space, err error)
+	GetSpaceSummary(space cf.Space) (spaceWithSummary cf.Space, err error)
+	Create(name string) (createdSpace cf.Space, err error)
+	Rename(space cf.Space, newName string) (err error)
+	Delete(space cf.Space) (err error)
 }
 
 type CloudControllerSpaceRepository struct {
@@ -71,7 +77,11 @@ func (repo CloudControllerSpaceRepository) FindByName(name string) (space cf.Spa
 }
 
 func (repo CloudControllerSpaceRepository) GetSummary() (space cf.Space, err error) {
-	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.Target, repo.config.Space.Guid)
+	return repo.GetSpaceSummary(repo.config.Space)
+}
+
+func (repo CloudControllerSpaceRepository) GetSpaceSummary(space cf.Space) (spaceWithSummary cf.Space, err error) {
+	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.Target, space.Guid)
 	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
 	if err != nil {
 		return
@@ -87,8 +97,57 @@ func (repo CloudControllerSpaceRepository) GetSummary() (space cf.Space, err err
 	applications := extractApplicationsFromSummary(response.Apps)
 	serviceInstances := extractServiceInstancesFromSummary(response.ServiceInstances, response.Apps)
 
-	space = cf.Space{Name: response.Name, Guid: response.Guid, Applications: applications, ServiceInstances: serviceInstances}
+	spaceWithSummary = cf.Space{Name: response.Name, Guid: response.Guid, Applications: applications, ServiceInstances: serviceInstances}
+
+	return
+}
+
+func (repo CloudControllerSpaceRepository) Create(name string) (createdSpace cf.Space, err error) {
+	path := fmt.Sprintf("%s/v2/spaces", repo.config.Target)
+	data, err := json.Marshal(map[string]string{"name": name, "organization_guid": repo.config.Organization.Guid})
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("POST", path, repo.config.AccessToken, bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+
+	resource := new(Resource)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, resource)
+	if err != nil {
+		return
+	}
+
+	createdSpace = cf.Space{Name: resource.Entity.Name, Guid: resource.Metadata.Guid}
+	return
+}
+
+func (repo CloudControllerSpaceRepository) Rename(space cf.Space, newName string) (err error) {
+	path := fmt.Sprintf("%s/v2/spaces/%s", repo.config.Target, space.Guid)
+	data, err := json.Marshal(map[string]string{"name": newName})
+	if err != nil {
+		return
+	}
+
+	request, err := NewRequest("PUT", path, repo.config.AccessToken, bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func (repo CloudControllerSpaceRepository) Delete(space cf.Space) (err error) {
+	path := fmt.Sprintf("%s/v2/spaces/%s?recursive=true", repo.config.Target, space.Guid)
+	request, err := NewRequest("DELETE", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
 
+	_, err = repo.apiClient.PerformRequest(request)
 	return
 }
 
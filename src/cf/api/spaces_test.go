@@ -244,3 +244,95 @@ func TestGetSummary(t *testing.T) {
 	assert.Equal(t, instance1.ApplicationNames[0], "app1")
 	assert.Equal(t, instance1.ApplicationNames[1], "app2")
 }
+
+var otherSpaceSummaryEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/spaces/other-space-guid/summary",
+	nil,
+	spaceSummaryResponse,
+)
+
+func TestGetSpaceSummary(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(otherSpaceSummaryEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken: "BEARER my_access_token",
+		Target:      ts.URL,
+		Space:       cf.Space{Guid: "my-space-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerSpaceRepository(config, client)
+
+	space, err := repo.GetSpaceSummary(cf.Space{Name: "development", Guid: "other-space-guid"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(space.Applications))
+	assert.Equal(t, 1, len(space.ServiceInstances))
+}
+
+var createSpaceEndpoint = testhelpers.CreateEndpoint(
+	"POST",
+	"/v2/spaces",
+	testhelpers.RequestBodyMatcher(`{"name":"my-space","organization_guid":"my-org-guid"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated, Body: `
+{
+  "metadata": { "guid": "my-space-guid" },
+  "entity": { "name": "my-space" }
+}`},
+)
+
+func TestCreateSpace(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(createSpaceEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{
+		AccessToken:  "BEARER my_access_token",
+		Target:       ts.URL,
+		Organization: cf.Organization{Guid: "my-org-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerSpaceRepository(config, client)
+
+	space, err := repo.Create("my-space")
+	assert.NoError(t, err)
+	assert.Equal(t, space.Name, "my-space")
+	assert.Equal(t, space.Guid, "my-space-guid")
+}
+
+var renameSpaceEndpoint = testhelpers.CreateEndpoint(
+	"PUT",
+	"/v2/spaces/my-space-guid",
+	testhelpers.RequestBodyMatcher(`{"name":"my-new-space"}`),
+	testhelpers.TestResponse{Status: http.StatusCreated},
+)
+
+func TestRenameSpace(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(renameSpaceEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token", Target: ts.URL}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerSpaceRepository(config, client)
+
+	err := repo.Rename(cf.Space{Guid: "my-space-guid"}, "my-new-space")
+	assert.NoError(t, err)
+}
+
+var deleteSpaceEndpoint = testhelpers.CreateEndpoint(
+	"DELETE",
+	"/v2/spaces/my-space-guid?recursive=true",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusNoContent},
+)
+
+func TestDeleteSpace(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(deleteSpaceEndpoint))
+	defer ts.Close()
+
+	config := &configuration.Configuration{AccessToken: "BEARER my_access_token", Target: ts.URL}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	repo := NewCloudControllerSpaceRepository(config, client)
+
+	err := repo.Delete(cf.Space{Guid: "my-space-guid"})
+	assert.NoError(t, err)
+}
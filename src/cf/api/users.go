@@ -0,0 +1,198 @@
+package api
+
+import (
+	"cf"
+	"cf/configuration"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Role names map to the CC association holding the users with that role.
+var orgRoleToPathMap = map[string]string{
+	cf.OrgManager:     "managers",
+	cf.BillingManager: "billing_managers",
+	cf.OrgAuditor:     "auditors",
+}
+
+var spaceRoleToPathMap = map[string]string{
+	cf.SpaceManager:   "managers",
+	cf.SpaceDeveloper: "developers",
+	cf.SpaceAuditor:   "auditors",
+}
+
+type UserRepository interface {
+	FindByUsername(username string) (user cf.User, err error)
+	FindAllInOrgByRole(org cf.Organization) (usersByRole map[string][]cf.User, err error)
+	FindAllInSpaceByRole(space cf.Space) (usersByRole map[string][]cf.User, err error)
+	SetOrgRole(user cf.User, org cf.Organization, role string) (err error)
+	UnsetOrgRole(user cf.User, org cf.Organization, role string) (err error)
+	SetSpaceRole(user cf.User, space cf.Space, role string) (err error)
+	UnsetSpaceRole(user cf.User, space cf.Space, role string) (err error)
+}
+
+type CloudControllerUserRepository struct {
+	config    *configuration.Configuration
+	apiClient ApiClient
+}
+
+func NewCloudControllerUserRepository(config *configuration.Configuration, apiClient ApiClient) (repo CloudControllerUserRepository) {
+	repo.config = config
+	repo.apiClient = apiClient
+	return
+}
+
+func (repo CloudControllerUserRepository) FindByUsername(username string) (user cf.User, err error) {
+	users, err := repo.findUAAUsers(fmt.Sprintf(`userName Eq "%s"`, username))
+	if err != nil {
+		return
+	}
+
+	if len(users) == 0 {
+		err = errors.New(fmt.Sprintf("User %s not found", username))
+		return
+	}
+
+	user = users[0]
+	return
+}
+
+func (repo CloudControllerUserRepository) FindAllInOrgByRole(org cf.Organization) (usersByRole map[string][]cf.User, err error) {
+	usersByRole = map[string][]cf.User{}
+	for _, role := range cf.OrgRoles {
+		path := fmt.Sprintf("%s/v2/organizations/%s/%s", repo.config.Target, org.Guid, orgRoleToPathMap[role])
+		usersByRole[role], err = repo.findAllWithPath(path)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (repo CloudControllerUserRepository) FindAllInSpaceByRole(space cf.Space) (usersByRole map[string][]cf.User, err error) {
+	usersByRole = map[string][]cf.User{}
+	for _, role := range cf.SpaceRoles {
+		path := fmt.Sprintf("%s/v2/spaces/%s/%s", repo.config.Target, space.Guid, spaceRoleToPathMap[role])
+		usersByRole[role], err = repo.findAllWithPath(path)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// CC only knows user guids, so the usernames are looked up in UAA.
+func (repo CloudControllerUserRepository) findAllWithPath(path string) (users []cf.User, err error) {
+	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	response := new(ApiResponse)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, response)
+	if err != nil || len(response.Resources) == 0 {
+		return
+	}
+
+	guidFilters := []string{}
+	for _, r := range response.Resources {
+		guidFilters = append(guidFilters, fmt.Sprintf(`Id eq "%s"`, r.Metadata.Guid))
+	}
+
+	return repo.findUAAUsers(strings.Join(guidFilters, " or "))
+}
+
+func (repo CloudControllerUserRepository) findUAAUsers(filter string) (users []cf.User, err error) {
+	path := fmt.Sprintf("%s/Users?attributes=id,userName&filter=%s", repo.config.AuthorizationEndpoint, url.QueryEscape(filter))
+	request, err := NewRequest("GET", path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	response := new(UAAUserResources)
+	_, err = repo.apiClient.PerformRequestAndParseResponse(request, response)
+	if err != nil {
+		return
+	}
+
+	for _, r := range response.Resources {
+		users = append(users, cf.User{Username: r.Username, Guid: r.Id})
+	}
+	return
+}
+
+func (repo CloudControllerUserRepository) SetOrgRole(user cf.User, org cf.Organization, role string) (err error) {
+	rolePath, err := roleToPath(orgRoleToPathMap, role)
+	if err != nil {
+		return
+	}
+
+	err = repo.addOrgUser(user, org)
+	if err != nil {
+		return
+	}
+
+	path := fmt.Sprintf("%s/v2/organizations/%s/%s/%s", repo.config.Target, org.Guid, rolePath, user.Guid)
+	return repo.performRequest("PUT", path)
+}
+
+func (repo CloudControllerUserRepository) UnsetOrgRole(user cf.User, org cf.Organization, role string) (err error) {
+	rolePath, err := roleToPath(orgRoleToPathMap, role)
+	if err != nil {
+		return
+	}
+
+	path := fmt.Sprintf("%s/v2/organizations/%s/%s/%s", repo.config.Target, org.Guid, rolePath, user.Guid)
+	return repo.performRequest("DELETE", path)
+}
+
+// A user must belong to the org before holding a role in one of its spaces,
+// so the user is added to the targeted org first.
+func (repo CloudControllerUserRepository) SetSpaceRole(user cf.User, space cf.Space, role string) (err error) {
+	rolePath, err := roleToPath(spaceRoleToPathMap, role)
+	if err != nil {
+		return
+	}
+
+	err = repo.addOrgUser(user, repo.config.Organization)
+	if err != nil {
+		return
+	}
+
+	path := fmt.Sprintf("%s/v2/spaces/%s/%s/%s", repo.config.Target, space.Guid, rolePath, user.Guid)
+	return repo.performRequest("PUT", path)
+}
+
+func (repo CloudControllerUserRepository) UnsetSpaceRole(user cf.User, space cf.Space, role string) (err error) {
+	rolePath, err := roleToPath(spaceRoleToPathMap, role)
+	if err != nil {
+		return
+	}
+
+	path := fmt.Sprintf("%s/v2/spaces/%s/%s/%s", repo.config.Target, space.Guid, rolePath, user.Guid)
+	return repo.performRequest("DELETE", path)
+}
+
+func (repo CloudControllerUserRepository) addOrgUser(user cf.User, org cf.Organization) (err error) {
+	path := fmt.Sprintf("%s/v2/organizations/%s/users/%s", repo.config.Target, org.Guid, user.Guid)
+	return repo.performRequest("PUT", path)
+}
+
+func (repo CloudControllerUserRepository) performRequest(method, path string) (err error) {
+	request, err := NewRequest(method, path, repo.config.AccessToken, nil)
+	if err != nil {
+		return
+	}
+
+	_, err = repo.apiClient.PerformRequest(request)
+	return
+}
+
+func roleToPath(roleToPathMap map[string]string, role string) (path string, err error) {
+	path, found := roleToPathMap[role]
+	if !found {
+		err = errors.New(fmt.Sprintf("Invalid role %s", role))
+	}
+	return
+}
@@ -0,0 +1,201 @@
+package api_test
+
+import (
+	"cf"
+	. "cf/api"
+	"cf/configuration"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testhelpers"
+	"testing"
+)
+
+var uaaFindByUsernameEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/Users?attributes=id,userName&filter=userName+Eq+%22my-user%22",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    { "id": "my-user-guid", "userName": "my-user" }
+  ]
+}`},
+)
+
+func TestFindByUsername(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(uaaFindByUsernameEndpoint))
+	defer ts.Close()
+
+	repo := createUserRepo(ts.URL, ts.URL)
+
+	user, err := repo.FindByUsername("my-user")
+	assert.NoError(t, err)
+	assert.Equal(t, user, cf.User{Username: "my-user", Guid: "my-user-guid"})
+}
+
+var uaaNoUsersEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/Users?attributes=id,userName&filter=userName+Eq+%22my-user%22",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `{ "resources": [] }`},
+)
+
+func TestFindByUsernameWhenNotFound(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(uaaNoUsersEndpoint))
+	defer ts.Close()
+
+	repo := createUserRepo(ts.URL, ts.URL)
+
+	_, err := repo.FindByUsername("my-user")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "User my-user not found")
+}
+
+var orgManagersEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/v2/organizations/my-org-guid/managers",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    { "metadata": { "guid": "user-1-guid" }, "entity": {} },
+    { "metadata": { "guid": "user-2-guid" }, "entity": {} }
+  ]
+}`},
+)
+
+var uaaFindByIdsEndpoint = testhelpers.CreateEndpoint(
+	"GET",
+	"/Users?attributes=id,userName&filter=Id+eq+%22user-1-guid%22+or+Id+eq+%22user-2-guid%22",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusOK, Body: `
+{
+  "resources": [
+    { "id": "user-1-guid", "userName": "Super user 1" },
+    { "id": "user-2-guid", "userName": "Super user 2" }
+  ]
+}`},
+)
+
+func TestFindAllInOrgByRole(t *testing.T) {
+	ccServer := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/v2/organizations/my-org-guid/managers" {
+			orgManagersEndpoint(writer, request)
+		} else {
+			fmt.Fprint(writer, `{ "resources": [] }`)
+		}
+	}))
+	defer ccServer.Close()
+
+	uaaServer := httptest.NewTLSServer(http.HandlerFunc(uaaFindByIdsEndpoint))
+	defer uaaServer.Close()
+
+	repo := createUserRepo(ccServer.URL, uaaServer.URL)
+
+	usersByRole, err := repo.FindAllInOrgByRole(cf.Organization{Guid: "my-org-guid"})
+	assert.NoError(t, err)
+
+	managers := usersByRole[cf.OrgManager]
+	assert.Equal(t, len(managers), 2)
+	assert.Equal(t, managers[0], cf.User{Username: "Super user 1", Guid: "user-1-guid"})
+	assert.Equal(t, managers[1].Username, "Super user 2")
+	assert.Equal(t, len(usersByRole[cf.BillingManager]), 0)
+	assert.Equal(t, len(usersByRole[cf.OrgAuditor]), 0)
+}
+
+func TestSetOrgRole(t *testing.T) {
+	paths := []string{}
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, request.Method, "PUT")
+		paths = append(paths, request.URL.Path)
+		writer.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	repo := createUserRepo(ts.URL, ts.URL)
+
+	err := repo.SetOrgRole(cf.User{Guid: "my-user-guid"}, cf.Organization{Guid: "my-org-guid"}, cf.BillingManager)
+	assert.NoError(t, err)
+	assert.Equal(t, paths, []string{
+		"/v2/organizations/my-org-guid/users/my-user-guid",
+		"/v2/organizations/my-org-guid/billing_managers/my-user-guid",
+	})
+}
+
+var unsetOrgRoleEndpoint = testhelpers.CreateEndpoint(
+	"DELETE",
+	"/v2/organizations/my-org-guid/auditors/my-user-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusNoContent},
+)
+
+func TestUnsetOrgRole(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(unsetOrgRoleEndpoint))
+	defer ts.Close()
+
+	repo := createUserRepo(ts.URL, ts.URL)
+
+	err := repo.UnsetOrgRole(cf.User{Guid: "my-user-guid"}, cf.Organization{Guid: "my-org-guid"}, cf.OrgAuditor)
+	assert.NoError(t, err)
+}
+
+func TestSetSpaceRole(t *testing.T) {
+	paths := []string{}
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, request.Method, "PUT")
+		paths = append(paths, request.URL.Path)
+		writer.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+
+	repo := createUserRepo(ts.URL, ts.URL)
+
+	err := repo.SetSpaceRole(cf.User{Guid: "my-user-guid"}, cf.Space{Guid: "my-space-guid"}, cf.SpaceDeveloper)
+	assert.NoError(t, err)
+	assert.Equal(t, paths, []string{
+		"/v2/organizations/my-org-guid/users/my-user-guid",
+		"/v2/spaces/my-space-guid/developers/my-user-guid",
+	})
+}
+
+var unsetSpaceRoleEndpoint = testhelpers.CreateEndpoint(
+	"DELETE",
+	"/v2/spaces/my-space-guid/managers/my-user-guid",
+	nil,
+	testhelpers.TestResponse{Status: http.StatusNoContent},
+)
+
+func TestUnsetSpaceRole(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(unsetSpaceRoleEndpoint))
+	defer ts.Close()
+
+	repo := createUserRepo(ts.URL, ts.URL)
+
+	err := repo.UnsetSpaceRole(cf.User{Guid: "my-user-guid"}, cf.Space{Guid: "my-space-guid"}, cf.SpaceManager)
+	assert.NoError(t, err)
+}
+
+func TestSetRoleWithInvalidRole(t *testing.T) {
+	repo := createUserRepo("https://api.example.com", "https://uaa.example.com")
+
+	err := repo.SetOrgRole(cf.User{Guid: "my-user-guid"}, cf.Organization{Guid: "my-org-guid"}, cf.SpaceDeveloper)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid role SpaceDeveloper")
+
+	err = repo.SetSpaceRole(cf.User{Guid: "my-user-guid"}, cf.Space{Guid: "my-space-guid"}, "Janitor")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid role Janitor")
+}
+
+func createUserRepo(ccTarget string, uaaTarget string) (repo UserRepository) {
+	config := &configuration.Configuration{
+		AccessToken:           "BEARER my_access_token",
+		Target:                ccTarget,
+		AuthorizationEndpoint: uaaTarget,
+		Organization:          cf.Organization{Guid: "my-org-guid"},
+	}
+	client := NewApiClient(&testhelpers.FakeAuthenticator{})
+	return NewCloudControllerUserRepository(config, client)
+}
@@ -44,7 +44,7 @@ OPTIONS:
    {{end}}{{else}}
 {{end}}`
 
-	termUI := new(terminal.TerminalUI)
+	termUI := terminal.NewTerminalUI()
 	configRepo := configuration.NewConfigurationDiskRepository()
 	config, err := configRepo.Get()
 	if err != nil {
@@ -78,11 +78,244 @@ OPTIONS:
 				cmdRunner.Run(cmd, c)
 			},
 		},
+		{
+			Name:        "orgs",
+			ShortName:   "o",
+			Description: "List all orgs",
+			Usage:       "cf orgs",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewOrgs()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "org",
+			Description: "Show org info",
+			Usage:       "cf org <organization>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewShowOrg()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "create-org",
+			Description: "Create a org",
+			Usage:       "cf create-org <organization>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewCreateOrg()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "rename-org",
+			Description: "Rename a org",
+			Usage:       "cf rename-org <organization> <new organization>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewRenameOrg()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "delete-org",
+			Description: "Delete a org and everything in it",
+			Usage:       "cf delete-org -f <organization>",
+			Flags: []cli.Flag{
+				cli.BoolFlag{"f", "force deletion without confirmation"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewDeleteOrg()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "spaces",
+			Description: "List all spaces in the currently targeted org",
+			Usage:       "cf spaces",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewSpaces()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "space",
+			Description: "Show space info",
+			Usage:       "cf space <space>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewShowSpace()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "create-space",
+			Description: "Create a space in the currently targeted org",
+			Usage:       "cf create-space <space>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewCreateSpace()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "rename-space",
+			Description: "Rename a space",
+			Usage:       "cf rename-space <space> <new space>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewRenameSpace()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "delete-space",
+			Description: "Delete a space and everything in it",
+			Usage:       "cf delete-space -f <space>",
+			Flags: []cli.Flag{
+				cli.BoolFlag{"f", "force deletion without confirmation"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewDeleteSpace()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "org-users",
+			Description: "Show org users by role",
+			Usage:       "cf org-users <organization>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewOrgUsers()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "set-org-role",
+			Description: "Assign an org role to a user",
+			Usage: "cf set-org-role <username> <organization> <role>\n\n" +
+				"   Roles: OrgManager, BillingManager, OrgAuditor",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewSetOrgRole()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "unset-org-role",
+			Description: "Remove an org role from a user",
+			Usage: "cf unset-org-role <username> <organization> <role>\n\n" +
+				"   Roles: OrgManager, BillingManager, OrgAuditor",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewUnsetOrgRole()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "space-users",
+			Description: "Show space users by role",
+			Usage:       "cf space-users <space>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewSpaceUsers()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "set-space-role",
+			Description: "Assign a space role to a user",
+			Usage: "cf set-space-role <username> <space> <role>\n\n" +
+				"   Roles: SpaceManager, SpaceDeveloper, SpaceAuditor",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewSetSpaceRole()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "unset-space-role",
+			Description: "Remove a space role from a user",
+			Usage: "cf unset-space-role <username> <space> <role>\n\n" +
+				"   Roles: SpaceManager, SpaceDeveloper, SpaceAuditor",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewUnsetSpaceRole()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "quotas",
+			Description: "List available quotas",
+			Usage:       "cf quotas",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewListQuotas()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "quota",
+			Description: "Show quota info",
+			Usage:       "cf quota <quota>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewShowQuota()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "create-quota",
+			Description: "Create a quota",
+			Usage:       "cf create-quota -m <memory> [-s <services>] [-r <routes>] [--allow-paid-service-plans] <quota>",
+			Flags: []cli.Flag{
+				cli.StringFlag{"m", "", "total memory limit (e.g. 1024M, 10G)"},
+				cli.StringFlag{"s", "100", "total number of service instances, -1 for unlimited"},
+				cli.StringFlag{"r", "1000", "total number of routes, -1 for unlimited"},
+				cli.BoolFlag{"allow-paid-service-plans", "allow paid service plans"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewCreateQuota()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "update-quota",
+			Description: "Update an existing quota",
+			Usage: "cf update-quota [-n <new name>] [-m <memory>] [-s <services>] [-r <routes>]\n" +
+				"                  [--allow-paid-service-plans | --disallow-paid-service-plans] <quota>",
+			Flags: []cli.Flag{
+				cli.StringFlag{"n", "", "new name"},
+				cli.StringFlag{"m", "", "total memory limit (e.g. 1024M, 10G)"},
+				cli.StringFlag{"s", "", "total number of service instances, -1 for unlimited"},
+				cli.StringFlag{"r", "", "total number of routes, -1 for unlimited"},
+				cli.BoolFlag{"allow-paid-service-plans", "allow paid service plans"},
+				cli.BoolFlag{"disallow-paid-service-plans", "disallow paid service plans"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewUpdateQuota()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "set-quota",
+			Description: "Assign a quota to an org",
+			Usage:       "cf set-quota <organization> <quota>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewSetQuota()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "quota-usage",
+			Description: "Show how much of its quota each org uses",
+			Usage:       "cf quota-usage [<organization>]",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewQuotaUsage()
+				cmdRunner.Run(cmd, c)
+			},
+		},
 		{
 			Name:        "login",
 			ShortName:   "l",
 			Description: "Log user in",
-			Usage:       "cf login [username]",
+			Usage: "cf login [-a <api url>] [-u <username>] [-p <password>] [-o <organization>] [-s <space>] [username]\n\n" +
+				"   Giving the password with -p or CF_PASSWORD logs in without prompting.\n" +
+				"   The username may also be given with CF_USERNAME.",
+			Flags: []cli.Flag{
+				cli.StringFlag{"a", "", "API endpoint to log in to"},
+				cli.StringFlag{"u", "", "username"},
+				cli.StringFlag{"p", "", "password"},
+				cli.StringFlag{"o", "", "organization to target"},
+				cli.StringFlag{"s", "", "space to target"},
+			},
 			Action: func(c *cli.Context) {
 				cmd := cmdFactory.NewLogin()
 				cmdRunner.Run(cmd, c)
@@ -114,7 +347,7 @@ OPTIONS:
 			Description: "Push an application",
 			Usage: "cf push --name <application> [--domain <domain>] [--host <hostname>] [--instances <num>]\n" +
 				"                                [--memory <memory>] [--buildpack <url>] [--no-[re]start] [--path <path to app>]\n" +
-				"                                [--stack <stack>]",
+				"                                [--stack <stack>] [--random-route] [--no-route] [--dry-run]",
 			Flags: []cli.Flag{
 				cli.StringFlag{"name", "", "name of the application"},
 				cli.StringFlag{"domain", "", "domain (for example: cfapps.io)"},
@@ -124,8 +357,11 @@ OPTIONS:
 				cli.StringFlag{"buildpack", "", "custom buildpack URL (for example: https://github.com/heroku/heroku-buildpack-play.git)"},
 				cli.BoolFlag{"no-start", "do not start an application after pushing"},
 				cli.BoolFlag{"no-restart", "do not restart an application after pushing"},
-				cli.StringFlag{"path", "", "path of application directory or zip file"},
+				cli.StringFlag{"path", "", "path of application directory or archive (zip, jar, war, tar.gz)"},
 				cli.StringFlag{"stack", "", "stack to use"},
+				cli.BoolFlag{"random-route", "create a route with a random hostname"},
+				cli.BoolFlag{"no-route", "do not create or bind a route"},
+				cli.BoolFlag{"dry-run", "show what push would do without changing anything"},
 			},
 			Action: func(c *cli.Context) {
 				cmd := cmdFactory.NewPush()
@@ -155,6 +391,15 @@ OPTIONS:
 				cmdRunner.Run(cmd, c)
 			},
 		},
+		{
+			Name:        "rename",
+			Description: "Rename an application",
+			Usage:       "cf rename <application> <new application>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewRenameApp()
+				cmdRunner.Run(cmd, c)
+			},
+		},
 		{
 			Name:        "start",
 			ShortName:   "s",
@@ -179,27 +424,65 @@ OPTIONS:
 			Name:        "create-service",
 			ShortName:   "cs",
 			Description: "Create service instance",
-			Usage: "cf create-service --offering <offering> --plan <plan> --name <service instance name>\n" +
-				"   cf create-service --offering user-provided --name <service name> --parameters \"<comma separated parameter names>\"",
+			Usage: "cf create-service --offering <offering> --plan <plan> --name <service instance name> [-c <json>] [--no-wait]\n" +
+				"   cf create-service --offering user-provided --name <service name> --parameters \"<comma separated parameter names>\"\n" +
+				"   cf create-service --offering user-provided --name <service name> -p '<json>' | -p @<json file> [-l <syslog drain url>] [-r <route service url>]",
 			Flags: []cli.Flag{
 				cli.StringFlag{"name", "", "name of the service instance"},
 				cli.StringFlag{"offering", "", "name of the service offering to use"},
 				cli.StringFlag{"plan", "", "name of the service plan to use"},
 				cli.StringFlag{"parameters", "", "list of comma separated parameter names to use for user-provided services (eg. \"n1,n2\")"},
+				cli.StringFlag{"p", "", "credentials for user-provided services, as a JSON object or @ followed by a JSON file"},
+				cli.StringFlag{"l", "", "syslog drain url for user-provided services"},
+				cli.StringFlag{"r", "", "route service url for user-provided services"},
+				cli.StringFlag{"c", "", "broker specific configuration, as a JSON object or the path to a JSON file"},
+				cli.BoolFlag{"wait", "wait for the service broker to finish (default)"},
+				cli.BoolFlag{"no-wait", "do not wait for the service broker to finish"},
 			},
 			Action: func(c *cli.Context) {
 				cmd := cmdFactory.NewCreateService()
 				cmdRunner.Run(cmd, c)
 			},
 		},
+		{
+			Name:        "update-service",
+			Description: "Change the plan or configuration of a service instance",
+			Usage:       "cf update-service [-p <plan>] [-c <json>] [--no-wait] <service instance>",
+			Flags: []cli.Flag{
+				cli.StringFlag{"p", "", "new plan, which must belong to the same service offering"},
+				cli.StringFlag{"c", "", "broker specific configuration, as a JSON object or the path to a JSON file"},
+				cli.BoolFlag{"wait", "wait for the service broker to finish (default)"},
+				cli.BoolFlag{"no-wait", "do not wait for the service broker to finish"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewUpdateService()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "update-user-provided-service",
+			ShortName:   "uups",
+			Description: "Update the credentials or urls of a user-provided service instance",
+			Usage:       "cf update-user-provided-service [-p '<json>' | -p @<json file>] [-l <syslog drain url>] [-r <route service url>] <service instance>",
+			Flags: []cli.Flag{
+				cli.StringFlag{"p", "", "new credentials, as a JSON object or @ followed by a JSON file"},
+				commands.OptionalStringFlag{cli.StringFlag{"l", "", "new syslog drain url, '' to remove it"}},
+				commands.OptionalStringFlag{cli.StringFlag{"r", "", "new route service url, '' to remove it"}},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewUpdateUserProvidedService()
+				cmdRunner.Run(cmd, c)
+			},
+		},
 		{
 			Name:        "bind-service",
 			ShortName:   "bs",
 			Description: "Bind a service instance to an application",
-			Usage:       "cf bind-service --app <application name> --service <service instance name>",
+			Usage:       "cf bind-service --app <application name> --service <service instance name> [-c <json>]",
 			Flags: []cli.Flag{
 				cli.StringFlag{"app", "", "name of the application"},
 				cli.StringFlag{"service", "", "name of the service instance to bind to the application"},
+				cli.StringFlag{"c", "", "broker specific configuration for the binding, as a JSON object or the path to a JSON file"},
 			},
 			Action: func(c *cli.Context) {
 				cmd := cmdFactory.NewBindService()
@@ -220,26 +503,224 @@ OPTIONS:
 				cmdRunner.Run(cmd, c)
 			},
 		},
+		{
+			Name:        "rebind-services",
+			Description: "Move the apps bound to a service instance over to another one",
+			Usage:       "cf rebind-services --from <service instance name> --to <service instance name>",
+			Flags: []cli.Flag{
+				cli.StringFlag{"from", "", "name of the service instance the apps are bound to"},
+				cli.StringFlag{"to", "", "name of the service instance to bind the apps to"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewRebindServices()
+				cmdRunner.Run(cmd, c)
+			},
+		},
 		{
 			Name:        "delete-service",
 			ShortName:   "ds",
 			Description: "Delete a service instance",
-			Usage:       "cf delete-service <service instance name>",
+			Usage:       "cf delete-service [--no-wait] <service instance name>",
+			Flags: []cli.Flag{
+				cli.BoolFlag{"wait", "wait for the service broker to finish (default)"},
+				cli.BoolFlag{"no-wait", "do not wait for the service broker to finish"},
+			},
 			Action: func(c *cli.Context) {
 				cmd := cmdFactory.NewDeleteService()
 				cmdRunner.Run(cmd, c)
 			},
 		},
+		{
+			Name:        "rename-service",
+			Description: "Rename a service instance",
+			Usage:       "cf rename-service <service instance> <new service instance>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewRenameService()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "create-service-key",
+			Description: "Create a key with credentials for a service instance",
+			Usage:       "cf create-service-key <service instance> <service key>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewCreateServiceKey()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "service-keys",
+			Description: "List the keys of a service instance",
+			Usage:       "cf service-keys <service instance>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewListServiceKeys()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "service-key",
+			Description: "Show the credentials of a service key as JSON",
+			Usage:       "cf service-key <service instance> <service key>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewShowServiceKey()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "delete-service-key",
+			Description: "Delete a key of a service instance",
+			Usage:       "cf delete-service-key -f <service instance> <service key>",
+			Flags: []cli.Flag{
+				cli.BoolFlag{"f", "force deletion without confirmation"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewDeleteServiceKey()
+				cmdRunner.Run(cmd, c)
+			},
+		},
 		{
 			Name:        "routes",
 			ShortName:   "r",
-			Description: "List all routes",
-			Usage:       "cf routes",
+			Description: "List routes in the currently targeted space",
+			Usage:       "cf routes [--all]",
+			Flags: []cli.Flag{
+				cli.BoolFlag{"all", "list routes in every space of the targeted org"},
+			},
 			Action: func(c *cli.Context) {
 				cmd := cmdFactory.NewRoutes()
 				cmdRunner.Run(cmd, c)
 			},
 		},
+		{
+			Name:        "create-route",
+			ShortName:   "cr",
+			Description: "Create a url route in a space for later use",
+			Usage:       "cf create-route -n <host> <space> <domain>",
+			Flags: []cli.Flag{
+				cli.StringFlag{"n", "", "hostname"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewCreateRoute()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "delete-route",
+			Description: "Delete a route",
+			Usage:       "cf delete-route [-f] -n <host> <domain>",
+			Flags: []cli.Flag{
+				cli.StringFlag{"n", "", "hostname"},
+				cli.BoolFlag{"f", "force deletion without confirmation"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewDeleteRoute()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "map-route",
+			ShortName:   "mr",
+			Description: "Add a url route to an application",
+			Usage:       "cf map-route -n <host> <application> <domain>",
+			Flags: []cli.Flag{
+				cli.StringFlag{"n", "", "hostname"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewMapRoute()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "unmap-route",
+			ShortName:   "umr",
+			Description: "Remove a url route from an application",
+			Usage:       "cf unmap-route -n <host> <application> <domain>",
+			Flags: []cli.Flag{
+				cli.StringFlag{"n", "", "hostname"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewUnmapRoute()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "delete-orphaned-routes",
+			Description: "Delete all routes in the currently targeted space that are not bound to an app",
+			Usage:       "cf delete-orphaned-routes [-f]",
+			Flags: []cli.Flag{
+				cli.BoolFlag{"f", "force deletion without confirmation"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewDeleteOrphanedRoutes()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "domains",
+			Description: "List domains in the currently targeted org",
+			Usage:       "cf domains",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewDomains()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "create-domain",
+			Description: "Create a domain owned by an org",
+			Usage:       "cf create-domain <organization> <domain>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewCreateDomain()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "map-domain",
+			Description: "Make a domain available to a space",
+			Usage:       "cf map-domain <space> <domain>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewMapDomain()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "unmap-domain",
+			Description: "Remove a domain from a space",
+			Usage:       "cf unmap-domain <space> <domain>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewUnmapDomain()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "delete-domain",
+			Description: "Delete a domain",
+			Usage:       "cf delete-domain -f <domain>",
+			Flags: []cli.Flag{
+				cli.BoolFlag{"f", "force deletion without confirmation"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewDeleteDomain()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "set-default-domain",
+			Description: "Set the domain used by push in the currently targeted space",
+			Usage:       "cf set-default-domain <domain>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewSetDefaultDomain()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "service",
+			Description: "Show service instance info",
+			Usage:       "cf service <service instance>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewShowService()
+				cmdRunner.Run(cmd, c)
+			},
+		},
 		{
 			Name:        "services",
 			ShortName:   "sv",
@@ -259,6 +740,102 @@ OPTIONS:
 				cmdRunner.Run(cmd, c)
 			},
 		},
+		{
+			Name:        "marketplace",
+			ShortName:   "m",
+			Description: "List available offerings in the marketplace",
+			Usage:       "cf marketplace [-s <service>]",
+			Flags: []cli.Flag{
+				cli.StringFlag{"s", "", "show the plans of a service offering"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewMarketplaceServices()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "service-brokers",
+			Description: "List service brokers",
+			Usage:       "cf service-brokers",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewListServiceBrokers()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "create-service-broker",
+			Description: "Create a service broker",
+			Usage:       "cf create-service-broker <service broker> <username> <password> <url>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewCreateServiceBroker()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "update-service-broker",
+			Description: "Update a service broker",
+			Usage:       "cf update-service-broker <service broker> <username> <password> <url>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewUpdateServiceBroker()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "rename-service-broker",
+			Description: "Rename a service broker",
+			Usage:       "cf rename-service-broker <service broker> <new service broker>",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewRenameServiceBroker()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "delete-service-broker",
+			Description: "Delete a service broker",
+			Usage:       "cf delete-service-broker -f <service broker>",
+			Flags: []cli.Flag{
+				cli.BoolFlag{"f", "force deletion without confirmation"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewDeleteServiceBroker()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "service-access",
+			Description: "List service plan access by org",
+			Usage:       "cf service-access",
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewServiceAccess()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "enable-service-access",
+			Description: "Give orgs access to the plans of a service",
+			Usage:       "cf enable-service-access [-p <plan>] [-o <organization>] <service>",
+			Flags: []cli.Flag{
+				cli.StringFlag{"p", "", "only enable this plan"},
+				cli.StringFlag{"o", "", "only enable access for this org"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewEnableServiceAccess()
+				cmdRunner.Run(cmd, c)
+			},
+		},
+		{
+			Name:        "disable-service-access",
+			Description: "Take away orgs' access to the plans of a service",
+			Usage:       "cf disable-service-access [-p <plan>] [-o <organization>] <service>",
+			Flags: []cli.Flag{
+				cli.StringFlag{"p", "", "only disable this plan"},
+				cli.StringFlag{"o", "", "only disable access for this org"},
+			},
+			Action: func(c *cli.Context) {
+				cmd := cmdFactory.NewDisableServiceAccess()
+				cmdRunner.Run(cmd, c)
+			},
+		},
 		{
 			Name:        "stacks",
 			Description: "List all stacks",
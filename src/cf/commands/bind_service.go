@@ -43,9 +43,15 @@ func (cmd *BindService) Run(c *cli.Context) {
 	app := cmd.appReq.GetApplication()
 	instance := cmd.serviceInstanceReq.GetServiceInstance()
 
+	params, err := parseServiceParameters(c.String("c"))
+	if err != nil {
+		cmd.ui.Failed("Error reading binding configuration", err)
+		return
+	}
+
 	cmd.ui.Say("Binding service %s to %s...", term.Cyan(instance.Name), term.Cyan(app.Name))
 
-	errorCode, err := cmd.serviceRepo.BindService(instance, app)
+	errorCode, err := cmd.serviceRepo.BindService(instance, app, params)
 	if err != nil && errorCode != 90003 {
 		cmd.ui.Failed("Failed binding service", err)
 		return
@@ -5,6 +5,8 @@ import (
 	"cf/api"
 	. "cf/commands"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
 	"testhelpers"
 	"testing"
 )
@@ -32,6 +34,29 @@ func TestBindCommand(t *testing.T) {
 	assert.Contains(t, fakeUI.Outputs[1], "OK")
 }
 
+func TestBindCommandWithConfigurationFile(t *testing.T) {
+	app := cf.Application{Name: "my-app", Guid: "my-app-guid"}
+	serviceInstance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{
+		Application:     app,
+		ServiceInstance: serviceInstance,
+	}
+
+	file, err := ioutil.TempFile("", "binding-config")
+	assert.NoError(t, err)
+	defer os.Remove(file.Name())
+
+	_, err = file.WriteString(`{"role":"read-only"}`)
+	assert.NoError(t, err)
+	file.Close()
+
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+	fakeUI := callBindService([]string{"--service", "my-service", "--app", "my-app", "-c", file.Name()}, reqFactory, serviceRepo)
+
+	assert.Contains(t, fakeUI.Outputs[1], "OK")
+	assert.Equal(t, serviceRepo.BindServiceParams, map[string]interface{}{"role": "read-only"})
+}
+
 func TestBindCommandIfServiceIsAlreadyBound(t *testing.T) {
 	app := cf.Application{Name: "my-app", Guid: "my-app-guid"}
 	serviceInstance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type CreateDomain struct {
+	ui         term.UI
+	orgRepo    api.OrganizationRepository
+	domainRepo api.DomainRepository
+}
+
+func NewCreateDomain(ui term.UI, orgRepo api.OrganizationRepository, domainRepo api.DomainRepository) (cmd *CreateDomain) {
+	cmd = new(CreateDomain)
+	cmd.ui = ui
+	cmd.orgRepo = orgRepo
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *CreateDomain) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "create-domain")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *CreateDomain) Run(c *cli.Context) {
+	orgName := c.Args()[0]
+	domainName := c.Args()[1]
+
+	org, err := cmd.orgRepo.FindByName(orgName)
+	if err != nil {
+		cmd.ui.Failed("Error finding org", err)
+		return
+	}
+
+	cmd.ui.Say("Creating domain %s for org %s...", term.Cyan(domainName), term.Cyan(org.Name))
+
+	_, err = cmd.domainRepo.Create(cf.Domain{Name: domainName}, org)
+	if err != nil {
+		cmd.ui.Failed("Error creating domain", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
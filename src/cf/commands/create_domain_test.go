@@ -0,0 +1,71 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestCreateDomainRequirements(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	callCreateDomain([]string{"my-org", "example.com"}, reqFactory, orgRepo, domainRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callCreateDomain([]string{"my-org", "example.com"}, reqFactory, orgRepo, domainRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestCreateDomainFailsWithUsage(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callCreateDomain([]string{"my-org"}, reqFactory, orgRepo, domainRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCreateDomain([]string{"my-org", "example.com"}, reqFactory, orgRepo, domainRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestCreateDomain(t *testing.T) {
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByName: org}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callCreateDomain([]string{"my-org", "example.com"}, reqFactory, orgRepo, domainRepo)
+
+	assert.Equal(t, orgRepo.OrganizationName, "my-org")
+	assert.Contains(t, ui.Outputs[0], "Creating domain")
+	assert.Contains(t, ui.Outputs[0], "example.com")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Equal(t, domainRepo.CreateDomainDomainToCreate.Name, "example.com")
+	assert.Equal(t, domainRepo.CreateDomainOwningOrg, org)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestCreateDomainWhenOrgIsNotFound(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByNameErr: true}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callCreateDomain([]string{"my-org", "example.com"}, reqFactory, orgRepo, domainRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Error finding org")
+	assert.Equal(t, domainRepo.CreateDomainDomainToCreate.Name, "")
+}
+
+func callCreateDomain(args []string, reqFactory *testhelpers.FakeReqFactory, orgRepo *testhelpers.FakeOrgRepository, domainRepo *testhelpers.FakeDomainRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("create-domain", args)
+	cmd := NewCreateDomain(ui, orgRepo, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type CreateOrg struct {
+	ui      term.UI
+	orgRepo api.OrganizationRepository
+}
+
+func NewCreateOrg(ui term.UI, orgRepo api.OrganizationRepository) (cmd *CreateOrg) {
+	cmd = new(CreateOrg)
+	cmd.ui = ui
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd *CreateOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "create-org")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *CreateOrg) Run(c *cli.Context) {
+	name := c.Args()[0]
+
+	cmd.ui.Say("Creating org %s...", term.Cyan(name))
+
+	_, err := cmd.orgRepo.Create(name)
+	if err != nil {
+		cmd.ui.Failed("Error creating org", err)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	cmd.ui.Say("TIP: Use 'cf target -o %s' to target the new org", name)
+}
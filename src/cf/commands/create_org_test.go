@@ -0,0 +1,47 @@
+package commands_test
+
+import (
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestCreateOrgFailsWithUsage(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callCreateOrg([]string{}, reqFactory, orgRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCreateOrg([]string{"my-org"}, reqFactory, orgRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestCreateOrgRequirements(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callCreateOrg([]string{"my-org"}, reqFactory, orgRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestCreateOrg(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callCreateOrg([]string{"my-org"}, reqFactory, orgRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Creating org")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Equal(t, orgRepo.CreateName, "my-org")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func callCreateOrg(args []string, reqFactory *testhelpers.FakeReqFactory, orgRepo *testhelpers.FakeOrgRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("create-org", args)
+	cmd := NewCreateOrg(ui, orgRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/formatters"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type CreateQuota struct {
+	ui        term.UI
+	quotaRepo api.QuotaRepository
+}
+
+func NewCreateQuota(ui term.UI, quotaRepo api.QuotaRepository) (cmd *CreateQuota) {
+	cmd = new(CreateQuota)
+	cmd.ui = ui
+	cmd.quotaRepo = quotaRepo
+	return
+}
+
+func (cmd *CreateQuota) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 || c.String("m") == "" {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "create-quota")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *CreateQuota) Run(c *cli.Context) {
+	memoryLimit, err := formatters.ToMegabytes(c.String("m"))
+	if err != nil {
+		cmd.ui.Failed("Invalid memory limit", err)
+		return
+	}
+
+	totalServices, err := parseQuotaLimit(c.String("s"))
+	if err != nil {
+		cmd.ui.Failed("Invalid services limit", err)
+		return
+	}
+
+	totalRoutes, err := parseQuotaLimit(c.String("r"))
+	if err != nil {
+		cmd.ui.Failed("Invalid routes limit", err)
+		return
+	}
+
+	quota := cf.QuotaDefinition{
+		Name:                    c.Args()[0],
+		MemoryLimit:             memoryLimit,
+		TotalServices:           totalServices,
+		TotalRoutes:             totalRoutes,
+		NonBasicServicesAllowed: c.Bool("allow-paid-service-plans"),
+	}
+
+	cmd.ui.Say("Creating quota %s...", term.Cyan(quota.Name))
+
+	_, err = cmd.quotaRepo.Create(quota)
+	if err != nil {
+		cmd.ui.Failed("Error creating quota", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
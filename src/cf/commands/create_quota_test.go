@@ -0,0 +1,89 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestCreateQuotaFailsWithUsage(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callCreateQuota([]string{"my-quota"}, reqFactory, quotaRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCreateQuota([]string{"-m", "1G"}, reqFactory, quotaRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCreateQuota([]string{"-m", "1G", "my-quota"}, reqFactory, quotaRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestCreateQuotaRequirements(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callCreateQuota([]string{"-m", "1G", "my-quota"}, reqFactory, quotaRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestCreateQuota(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callCreateQuota([]string{"-m", "2G", "-s", "5", "-r", "50", "--allow-paid-service-plans", "my-quota"}, reqFactory, quotaRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Creating quota")
+	assert.Contains(t, ui.Outputs[0], "my-quota")
+	assert.Equal(t, quotaRepo.CreatedQuota, cf.QuotaDefinition{
+		Name:                    "my-quota",
+		MemoryLimit:             2048,
+		TotalServices:           5,
+		TotalRoutes:             50,
+		NonBasicServicesAllowed: true,
+	})
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestCreateQuotaWithUnlimitedServicesAndRoutes(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	callCreateQuota([]string{"-m", "2G", "-s", "-1", "-r", "-1", "my-quota"}, reqFactory, quotaRepo)
+
+	assert.Equal(t, quotaRepo.CreatedQuota.TotalServices, -1)
+	assert.Equal(t, quotaRepo.CreatedQuota.TotalRoutes, -1)
+}
+
+func TestCreateQuotaWithInvalidLimit(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callCreateQuota([]string{"-m", "2G", "-r", "-2", "my-quota"}, reqFactory, quotaRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Invalid routes limit")
+	assert.Equal(t, quotaRepo.CreatedQuota.Name, "")
+}
+
+func TestCreateQuotaWithInvalidMemory(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callCreateQuota([]string{"-m", "lots", "my-quota"}, reqFactory, quotaRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Invalid memory limit")
+	assert.Equal(t, quotaRepo.CreatedQuota.Name, "")
+}
+
+func callCreateQuota(args []string, reqFactory *testhelpers.FakeReqFactory, quotaRepo *testhelpers.FakeQuotaRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("create-quota", args)
+	cmd := NewCreateQuota(ui, quotaRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
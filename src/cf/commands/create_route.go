@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type CreateRoute struct {
+	ui         term.UI
+	routeRepo  api.RouteRepository
+	spaceRepo  api.SpaceRepository
+	domainRepo api.DomainRepository
+}
+
+func NewCreateRoute(ui term.UI, routeRepo api.RouteRepository, spaceRepo api.SpaceRepository, domainRepo api.DomainRepository) (cmd *CreateRoute) {
+	cmd = new(CreateRoute)
+	cmd.ui = ui
+	cmd.routeRepo = routeRepo
+	cmd.spaceRepo = spaceRepo
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *CreateRoute) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 || c.String("n") == "" {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "create-route")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+	}
+	return
+}
+
+func (cmd *CreateRoute) Run(c *cli.Context) {
+	spaceName := c.Args()[0]
+	domainName := c.Args()[1]
+
+	space, err := cmd.spaceRepo.FindByName(spaceName)
+	if err != nil {
+		cmd.ui.Failed("Error finding space", err)
+		return
+	}
+
+	domain, err := cmd.domainRepo.FindByName(domainName)
+	if err != nil {
+		cmd.ui.Failed("Error finding domain", err)
+		return
+	}
+
+	newRoute := cf.Route{Host: c.String("n"), Domain: domain}
+
+	cmd.ui.Say("Creating route %s in space %s...", term.Cyan(newRoute.URL()), term.Cyan(space.Name))
+
+	_, _, err = cmd.routeRepo.CreateInSpace(newRoute, domain, space)
+	if err != nil {
+		cmd.ui.Failed("Error creating route", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
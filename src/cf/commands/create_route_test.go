@@ -0,0 +1,69 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestCreateRouteRequirements(t *testing.T) {
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	callCreateRoute([]string{"-n", "my-host", "my-space", "example.com"}, reqFactory, routeRepo, spaceRepo, domainRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: false, SpaceSuccess: true}
+	callCreateRoute([]string{"-n", "my-host", "my-space", "example.com"}, reqFactory, routeRepo, spaceRepo, domainRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestCreateRouteFailsWithUsage(t *testing.T) {
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callCreateRoute([]string{"my-space", "example.com"}, reqFactory, routeRepo, spaceRepo, domainRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCreateRoute([]string{"-n", "my-host", "my-space"}, reqFactory, routeRepo, spaceRepo, domainRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCreateRoute([]string{"-n", "my-host", "my-space", "example.com"}, reqFactory, routeRepo, spaceRepo, domainRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestCreateRoute(t *testing.T) {
+	space := cf.Space{Name: "my-space", Guid: "my-space-guid"}
+	domain := cf.Domain{Name: "example.com", Guid: "example-domain-guid"}
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	spaceRepo := &testhelpers.FakeSpaceRepository{SpaceByName: space}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callCreateRoute([]string{"-n", "my-host", "my-space", "example.com"}, reqFactory, routeRepo, spaceRepo, domainRepo)
+
+	assert.Equal(t, spaceRepo.SpaceName, "my-space")
+	assert.Equal(t, domainRepo.FindByNameName, "example.com")
+
+	assert.Contains(t, ui.Outputs[0], "Creating route")
+	assert.Contains(t, ui.Outputs[0], "my-host.example.com")
+	assert.Contains(t, ui.Outputs[0], "my-space")
+	assert.Equal(t, routeRepo.CreatedRoute.Host, "my-host")
+	assert.Equal(t, routeRepo.CreatedRouteDomain, domain)
+	assert.Equal(t, routeRepo.CreatedRouteSpace, space)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func callCreateRoute(args []string, reqFactory *testhelpers.FakeReqFactory, routeRepo *testhelpers.FakeRouteRepository, spaceRepo *testhelpers.FakeSpaceRepository, domainRepo *testhelpers.FakeDomainRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("create-route", args)
+	cmd := NewCreateRoute(ui, routeRepo, spaceRepo, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -3,6 +3,7 @@ package commands
 import (
 	"cf"
 	"cf/api"
+	"cf/configuration"
 	"cf/requirements"
 	term "cf/terminal"
 	"errors"
@@ -14,19 +15,22 @@ import (
 type CreateService struct {
 	ui          term.UI
 	serviceRepo api.ServiceRepository
+	waiter      serviceOperationWaiter
 }
 
-func NewCreateService(ui term.UI, sR api.ServiceRepository) (cmd CreateService) {
+func NewCreateService(ui term.UI, config *configuration.Configuration, sR api.ServiceRepository) (cmd CreateService) {
 	cmd.ui = ui
 	cmd.serviceRepo = sR
+	cmd.waiter = newServiceOperationWaiter(ui, config, sR)
 	return
 }
 
 func (cmd CreateService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
 	offeringName := c.String("offering")
 	parameterList := c.String("parameters")
+	credentials := c.String("p")
 
-	if offeringName == "user-provided" && parameterList == "" {
+	if offeringName == "user-provided" && parameterList == "" && credentials == "" {
 		err = errors.New("Incorrect Usage")
 		cmd.ui.FailWithUsage(c, "create-service")
 		return
@@ -40,25 +44,61 @@ func (cmd CreateService) Run(c *cli.Context) {
 	offeringName := c.String("offering")
 
 	if offeringName == "user-provided" {
-		params := c.String("parameters")
-		cmd.createUserProvidedService(name, params)
+		cmd.createUserProvidedService(c, name)
 	} else {
+		wait, err := serviceWaitOption(c)
+		if err != nil {
+			cmd.ui.Failed("Error reading service options", err)
+			return
+		}
+
+		params, err := parseServiceParameters(c.String("c"))
+		if err != nil {
+			cmd.ui.Failed("Error reading service configuration", err)
+			return
+		}
+
 		planName := c.String("plan")
-		cmd.createService(name, offeringName, planName)
+		cmd.createService(name, offeringName, planName, params, wait)
 	}
 }
 
-func (cmd CreateService) createUserProvidedService(name string, params string) {
-	paramsMap := make(map[string]string)
-	params = strings.Trim(params, `"`)
+// Credentials given with -p are used as they are, otherwise the user is
+// asked for each of the names listed with --parameters.
+func (cmd CreateService) createUserProvidedService(c *cli.Context, name string) {
+	instance := cf.ServiceInstance{
+		Name:            name,
+		SyslogDrainUrl:  c.String("l"),
+		RouteServiceUrl: c.String("r"),
+	}
 
-	for _, param := range strings.Split(params, ",") {
-		param = strings.Trim(param, " ")
-		paramsMap[param] = cmd.ui.Ask("%s%s", param, term.Cyan(">"))
+	params := c.String("parameters")
+	credentials := c.String("p")
+
+	if params != "" && credentials != "" {
+		cmd.ui.Failed("Error reading service options", errors.New("parameters and p cannot be used together"))
+		return
+	}
+
+	if credentials != "" {
+		var err error
+		instance.Credentials, err = parseUserProvidedCredentials(credentials)
+		if err != nil {
+			cmd.ui.Failed("Error reading credentials", err)
+			return
+		}
+	} else {
+		instance.Credentials = make(map[string]interface{})
+		params = strings.Trim(params, `"`)
+
+		for _, param := range strings.Split(params, ",") {
+			param = strings.Trim(param, " ")
+			instance.Credentials[param] = cmd.ui.Ask("%s%s", param, term.Cyan(">"))
+		}
 	}
 
 	cmd.ui.Say("Creating service...")
-	err := cmd.serviceRepo.CreateUserProvidedServiceInstance(name, paramsMap)
+	err := cmd.serviceRepo.CreateUserProvidedServiceInstance(instance)
 	if err != nil {
 		cmd.ui.Failed("Error creating user provided service instance", err)
 		return
@@ -68,7 +108,7 @@ func (cmd CreateService) createUserProvidedService(name string, params string) {
 	return
 }
 
-func (cmd CreateService) createService(name string, offeringName string, planName string) {
+func (cmd CreateService) createService(name string, offeringName string, planName string, params map[string]interface{}, wait bool) {
 	offerings, err := cmd.serviceRepo.GetServiceOfferings()
 	if err != nil {
 		cmd.ui.Failed("Error fetching offerings", err)
@@ -88,12 +128,18 @@ func (cmd CreateService) createService(name string, offeringName string, planNam
 	}
 
 	cmd.ui.Say("Creating service %s", term.Cyan(name))
-	err = cmd.serviceRepo.CreateServiceInstance(name, plan)
+	instance, err := cmd.serviceRepo.CreateServiceInstance(name, plan, params)
 	if err != nil {
 		cmd.ui.Failed("Error creating plan", err)
 		return
 	}
 
+	err = cmd.waiter.Follow(instance, "create", wait)
+	if err != nil {
+		cmd.ui.Failed("Error creating service", err)
+		return
+	}
+
 	cmd.ui.Ok()
 	return
 }
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type CreateServiceBroker struct {
+	ui         term.UI
+	brokerRepo api.ServiceBrokerRepository
+}
+
+func NewCreateServiceBroker(ui term.UI, brokerRepo api.ServiceBrokerRepository) (cmd *CreateServiceBroker) {
+	cmd = new(CreateServiceBroker)
+	cmd.ui = ui
+	cmd.brokerRepo = brokerRepo
+	return
+}
+
+func (cmd *CreateServiceBroker) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 4 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "create-service-broker")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *CreateServiceBroker) Run(c *cli.Context) {
+	args := c.Args()
+	broker := cf.ServiceBroker{
+		Name:     args[0],
+		Username: args[1],
+		Password: args[2],
+		Url:      args[3],
+	}
+
+	cmd.ui.Say("Creating service broker %s...", term.Cyan(broker.Name))
+
+	err := cmd.brokerRepo.Create(broker)
+	if err != nil {
+		cmd.ui.Failed("Error creating service broker", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,53 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestCreateServiceBrokerFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{}
+
+	ui := callCreateServiceBroker([]string{"my-broker", "user", "pass"}, reqFactory, brokerRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCreateServiceBroker([]string{"my-broker", "user", "pass", "http://broker.example.com"}, reqFactory, brokerRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestCreateServiceBrokerRequirements(t *testing.T) {
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callCreateServiceBroker([]string{"my-broker", "user", "pass", "http://broker.example.com"}, reqFactory, brokerRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestCreateServiceBroker(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{}
+
+	ui := callCreateServiceBroker([]string{"my-broker", "user", "pass", "http://broker.example.com"}, reqFactory, brokerRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Creating service broker")
+	assert.Contains(t, ui.Outputs[0], "my-broker")
+	assert.Equal(t, brokerRepo.CreatedServiceBroker, cf.ServiceBroker{
+		Name:     "my-broker",
+		Username: "user",
+		Password: "pass",
+		Url:      "http://broker.example.com",
+	})
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func callCreateServiceBroker(args []string, reqFactory *testhelpers.FakeReqFactory, brokerRepo *testhelpers.FakeServiceBrokerRepo) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("create-service-broker", args)
+	cmd := NewCreateServiceBroker(ui, brokerRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+const serviceKeyNameTakenErrorCode = 360001
+
+type CreateServiceKey struct {
+	ui                 term.UI
+	serviceRepo        api.ServiceRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewCreateServiceKey(ui term.UI, serviceRepo api.ServiceRepository) (cmd *CreateServiceKey) {
+	cmd = new(CreateServiceKey)
+	cmd.ui = ui
+	cmd.serviceRepo = serviceRepo
+	return
+}
+
+func (cmd *CreateServiceKey) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "create-service-key")
+		return
+	}
+
+	cmd.serviceInstanceReq = reqFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return
+}
+
+func (cmd *CreateServiceKey) Run(c *cli.Context) {
+	instance := cmd.serviceInstanceReq.GetServiceInstance()
+	keyName := c.Args()[1]
+
+	cmd.ui.Say("Creating service key %s for service instance %s...", term.Cyan(keyName), term.Cyan(instance.Name))
+
+	errorCode, err := cmd.serviceRepo.CreateServiceKey(instance, keyName)
+	if err != nil && errorCode != serviceKeyNameTakenErrorCode {
+		cmd.ui.Failed("Error creating service key", err)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	if errorCode == serviceKeyNameTakenErrorCode {
+		cmd.ui.Say("Service key %s already exists", term.Cyan(keyName))
+	}
+}
@@ -0,0 +1,68 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestCreateServiceKeyFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callCreateServiceKey([]string{"my-service"}, reqFactory, serviceRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCreateServiceKey([]string{"my-service", "my-key"}, reqFactory, serviceRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestCreateServiceKeyRequirements(t *testing.T) {
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: false}
+	callCreateServiceKey([]string{"my-service", "my-key"}, reqFactory, serviceRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	callCreateServiceKey([]string{"my-service", "my-key"}, reqFactory, serviceRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+	assert.Equal(t, reqFactory.ServiceInstanceName, "my-service")
+}
+
+func TestCreateServiceKey(t *testing.T) {
+	instance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: instance}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callCreateServiceKey([]string{"my-service", "my-key"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Creating service key")
+	assert.Contains(t, ui.Outputs[0], "my-key")
+	assert.Contains(t, ui.Outputs[0], "my-service")
+	assert.Contains(t, ui.Outputs[1], "OK")
+
+	assert.Equal(t, serviceRepo.CreateServiceKeyInstance, instance)
+	assert.Equal(t, serviceRepo.CreateServiceKeyName, "my-key")
+}
+
+func TestCreateServiceKeyWhenKeyAlreadyExists(t *testing.T) {
+	instance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: instance}
+	serviceRepo := &testhelpers.FakeServiceRepo{CreateServiceKeyErrorCode: 360001}
+
+	ui := callCreateServiceKey([]string{"my-service", "my-key"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[2], "already exists")
+}
+
+func callCreateServiceKey(args []string, reqFactory *testhelpers.FakeReqFactory, serviceRepo *testhelpers.FakeServiceRepo) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("create-service-key", args)
+	cmd := NewCreateServiceKey(ui, serviceRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
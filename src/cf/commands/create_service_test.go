@@ -4,7 +4,10 @@ import (
 	"cf"
 	"cf/api"
 	. "cf/commands"
+	"cf/configuration"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
 	"testhelpers"
 	"testing"
 )
@@ -43,7 +46,7 @@ func TestCreateUserProvidedService(t *testing.T) {
 	assert.Contains(t, fakeUI.Prompts[2], "baz")
 
 	assert.Equal(t, serviceRepo.CreateUserProvidedServiceInstanceName, "my-custom-service")
-	assert.Equal(t, serviceRepo.CreateUserProvidedServiceInstanceParameters, map[string]string{
+	assert.Equal(t, serviceRepo.CreateUserProvidedServiceInstanceParameters, map[string]interface{}{
 		"foo": "foo value",
 		"bar": "bar value",
 		"baz": "baz value",
@@ -53,6 +56,65 @@ func TestCreateUserProvidedService(t *testing.T) {
 	assert.Contains(t, fakeUI.Outputs[1], "OK")
 }
 
+func TestCreateUserProvidedServiceWithInlineCredentials(t *testing.T) {
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+	fakeUI := callCreateService(
+		[]string{"--offering", "user-provided", "--name", "my-custom-service",
+			"-p", `{"uri":"postgres://example.com","tls":{"ca":"cert"}}`,
+			"-l", "syslog://logs.example.com", "-r", "https://proxy.example.com"},
+		[]string{},
+		serviceRepo,
+	)
+
+	assert.Equal(t, len(fakeUI.Prompts), 0)
+	assert.Equal(t, serviceRepo.CreateUserProvidedServiceInstanceName, "my-custom-service")
+	assert.Equal(t, serviceRepo.CreateUserProvidedServiceInstanceParameters, map[string]interface{}{
+		"uri": "postgres://example.com",
+		"tls": map[string]interface{}{"ca": "cert"},
+	})
+	assert.Equal(t, serviceRepo.CreateUserProvidedServiceInstanceSyslogDrainUrl, "syslog://logs.example.com")
+	assert.Equal(t, serviceRepo.CreateUserProvidedServiceInstanceRouteServiceUrl, "https://proxy.example.com")
+
+	assert.Contains(t, fakeUI.Outputs[0], "Creating service")
+	assert.Contains(t, fakeUI.Outputs[1], "OK")
+}
+
+func TestCreateUserProvidedServiceWithCredentialsFile(t *testing.T) {
+	file, err := ioutil.TempFile("", "creds")
+	assert.NoError(t, err)
+	defer os.Remove(file.Name())
+
+	_, err = file.WriteString(`{"user":"me","password":"secret"}`)
+	assert.NoError(t, err)
+	file.Close()
+
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+	fakeUI := callCreateService(
+		[]string{"--offering", "user-provided", "--name", "my-custom-service", "-p", "@" + file.Name()},
+		[]string{},
+		serviceRepo,
+	)
+
+	assert.Contains(t, fakeUI.Outputs[1], "OK")
+	assert.Equal(t, serviceRepo.CreateUserProvidedServiceInstanceParameters, map[string]interface{}{
+		"user":     "me",
+		"password": "secret",
+	})
+}
+
+func TestCreateUserProvidedServiceWithInvalidCredentials(t *testing.T) {
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+	fakeUI := callCreateService(
+		[]string{"--offering", "user-provided", "--name", "my-custom-service", "-p", "uri,password"},
+		[]string{},
+		serviceRepo,
+	)
+
+	assert.Contains(t, fakeUI.Outputs[0], "FAILED")
+	assert.Contains(t, fakeUI.Outputs[2], "Credentials must be a JSON object")
+	assert.Equal(t, serviceRepo.CreateUserProvidedServiceInstanceName, "")
+}
+
 func TestCreateUserProvidedServiceWithNoParameterList(t *testing.T) {
 	serviceRepo := &testhelpers.FakeServiceRepo{}
 	fakeUI := callCreateService(
@@ -64,10 +126,133 @@ func TestCreateUserProvidedServiceWithNoParameterList(t *testing.T) {
 	assert.Contains(t, fakeUI.Outputs[0], "FAILED")
 }
 
+func TestCreateServiceWithConfiguration(t *testing.T) {
+	serviceOfferings := []cf.ServiceOffering{
+		cf.ServiceOffering{Label: "cleardb", Plans: []cf.ServicePlan{
+			cf.ServicePlan{Name: "spark", Guid: "cleardb-spark-guid"},
+		}},
+	}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: serviceOfferings}
+	fakeUI := callCreateService(
+		[]string{"--offering", "cleardb", "--plan", "spark", "--name", "my-cleardb-service", "-c", `{"region":"eu","backups":{"enabled":true}}`},
+		[]string{},
+		serviceRepo,
+	)
+
+	assert.Contains(t, fakeUI.Outputs[1], "OK")
+	assert.Equal(t, serviceRepo.CreateServiceInstanceParams, map[string]interface{}{
+		"region":  "eu",
+		"backups": map[string]interface{}{"enabled": true},
+	})
+}
+
+func TestCreateServiceWithInvalidConfiguration(t *testing.T) {
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	for _, config := range []string{`{"region":`, `["eu"]`, "no-such-file.json"} {
+		fakeUI := callCreateService(
+			[]string{"--offering", "cleardb", "--plan", "spark", "--name", "my-cleardb-service", "-c", config},
+			[]string{},
+			serviceRepo,
+		)
+
+		assert.Contains(t, fakeUI.Outputs[0], "FAILED")
+		assert.Contains(t, fakeUI.Outputs[2], "JSON object")
+		assert.Equal(t, serviceRepo.CreateServiceInstanceName, "")
+	}
+}
+
+func TestCreateServiceWaitsForAsynchronousBroker(t *testing.T) {
+	serviceOfferings := []cf.ServiceOffering{
+		cf.ServiceOffering{Label: "cleardb", Plans: []cf.ServicePlan{
+			cf.ServicePlan{Name: "spark", Guid: "cleardb-spark-guid"},
+		}},
+	}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceOfferings:                   serviceOfferings,
+		CreateServiceInstanceLastOperation: cf.LastOperation{Type: "create", State: "in progress"},
+		GetServiceInstanceResponses: []cf.ServiceInstance{
+			{LastOperation: cf.LastOperation{Type: "create", State: "in progress", Description: "provisioning"}},
+			{LastOperation: cf.LastOperation{Type: "create", State: "succeeded"}},
+		},
+	}
+	fakeUI := callCreateService(
+		[]string{"--offering", "cleardb", "--plan", "spark", "--name", "my-cleardb-service"},
+		[]string{},
+		serviceRepo,
+	)
+
+	assert.Contains(t, fakeUI.Outputs[0], "Creating service")
+	assert.Contains(t, fakeUI.Outputs[1], "my-cleardb-service")
+	assert.Contains(t, fakeUI.Outputs[1], "create in progress (provisioning)")
+	assert.Contains(t, fakeUI.Outputs[2], "OK")
+	assert.Equal(t, serviceRepo.GetServiceInstanceGuids, []string{"my-cleardb-service-guid", "my-cleardb-service-guid"})
+}
+
+func TestCreateServiceWhenBrokerFails(t *testing.T) {
+	serviceOfferings := []cf.ServiceOffering{
+		cf.ServiceOffering{Label: "cleardb", Plans: []cf.ServicePlan{
+			cf.ServicePlan{Name: "spark", Guid: "cleardb-spark-guid"},
+		}},
+	}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceOfferings: serviceOfferings,
+		GetServiceInstanceResponses: []cf.ServiceInstance{
+			{LastOperation: cf.LastOperation{Type: "create", State: "failed", Description: "out of capacity"}},
+		},
+	}
+	fakeUI := callCreateService(
+		[]string{"--offering", "cleardb", "--plan", "spark", "--name", "my-cleardb-service"},
+		[]string{},
+		serviceRepo,
+	)
+
+	assert.Contains(t, fakeUI.Outputs[1], "FAILED")
+	assert.Contains(t, fakeUI.Outputs[3], "could not create service my-cleardb-service: out of capacity")
+}
+
+func TestCreateServiceWithNoWait(t *testing.T) {
+	serviceOfferings := []cf.ServiceOffering{
+		cf.ServiceOffering{Label: "cleardb", Plans: []cf.ServicePlan{
+			cf.ServicePlan{Name: "spark", Guid: "cleardb-spark-guid"},
+		}},
+	}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceOfferings: serviceOfferings,
+		GetServiceInstanceResponses: []cf.ServiceInstance{
+			{LastOperation: cf.LastOperation{Type: "create", State: "in progress"}},
+		},
+	}
+	fakeUI := callCreateService(
+		[]string{"--offering", "cleardb", "--plan", "spark", "--name", "my-cleardb-service", "--no-wait"},
+		[]string{},
+		serviceRepo,
+	)
+
+	assert.Contains(t, fakeUI.Outputs[1], "create in progress")
+	assert.Contains(t, fakeUI.Outputs[2], "cf service my-cleardb-service")
+	assert.Contains(t, fakeUI.Outputs[3], "OK")
+	assert.Equal(t, len(serviceRepo.GetServiceInstanceGuids), 1)
+}
+
+func TestCreateServiceWithWaitAndNoWait(t *testing.T) {
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+	fakeUI := callCreateService(
+		[]string{"--offering", "cleardb", "--plan", "spark", "--name", "my-cleardb-service", "--wait", "--no-wait"},
+		[]string{},
+		serviceRepo,
+	)
+
+	assert.Contains(t, fakeUI.Outputs[0], "FAILED")
+	assert.Contains(t, fakeUI.Outputs[2], "wait and no-wait cannot be used together")
+	assert.Equal(t, serviceRepo.CreateServiceInstanceName, "")
+}
+
 func callCreateService(args []string, inputs []string, serviceRepo api.ServiceRepository) (fakeUI *testhelpers.FakeUI) {
 	fakeUI = &testhelpers.FakeUI{Inputs: inputs}
 	ctxt := testhelpers.NewContext("create-service", args)
-	cmd := NewCreateService(fakeUI, serviceRepo)
+	config := &configuration.Configuration{ServiceOperationTimeout: 300}
+	cmd := NewCreateService(fakeUI, config, serviceRepo)
 	reqFactory := &testhelpers.FakeReqFactory{}
 
 	testhelpers.RunCommand(cmd, ctxt, reqFactory)
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type CreateSpace struct {
+	ui        term.UI
+	config    *configuration.Configuration
+	spaceRepo api.SpaceRepository
+}
+
+func NewCreateSpace(ui term.UI, config *configuration.Configuration, spaceRepo api.SpaceRepository) (cmd *CreateSpace) {
+	cmd = new(CreateSpace)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.spaceRepo = spaceRepo
+	return
+}
+
+func (cmd *CreateSpace) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "create-space")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewOrganizationRequirement(),
+	}
+	return
+}
+
+func (cmd *CreateSpace) Run(c *cli.Context) {
+	name := c.Args()[0]
+
+	cmd.ui.Say("Creating space %s in org %s...", term.Cyan(name), term.Cyan(cmd.config.Organization.Name))
+
+	_, err := cmd.spaceRepo.Create(name)
+	if err != nil {
+		cmd.ui.Failed("Error creating space", err)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	cmd.ui.Say("TIP: Use 'cf target -s %s' to target the new space", name)
+}
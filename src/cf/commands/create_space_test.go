@@ -0,0 +1,53 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestCreateSpaceFailsWithUsage(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callCreateSpace([]string{}, reqFactory, spaceRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callCreateSpace([]string{"my-space"}, reqFactory, spaceRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestCreateSpaceRequirements(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: false}
+	callCreateSpace([]string{"my-space"}, reqFactory, spaceRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestCreateSpace(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callCreateSpace([]string{"my-space"}, reqFactory, spaceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Creating space")
+	assert.Contains(t, ui.Outputs[0], "my-space")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Equal(t, spaceRepo.CreateSpaceName, "my-space")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func callCreateSpace(args []string, reqFactory *testhelpers.FakeReqFactory, spaceRepo *testhelpers.FakeSpaceRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("create-space", args)
+	config := &configuration.Configuration{
+		Organization: cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+	}
+	cmd := NewCreateSpace(ui, config, spaceRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type DeleteDomain struct {
+	ui         term.UI
+	domainRepo api.DomainRepository
+}
+
+func NewDeleteDomain(ui term.UI, domainRepo api.DomainRepository) (cmd *DeleteDomain) {
+	cmd = new(DeleteDomain)
+	cmd.ui = ui
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *DeleteDomain) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "delete-domain")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewOrganizationRequirement(),
+	}
+	return
+}
+
+func (cmd *DeleteDomain) Run(c *cli.Context) {
+	domain, err := cmd.domainRepo.FindByNameInCurrentOrg(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding domain", err)
+		return
+	}
+
+	if !c.Bool("f") {
+		response := strings.ToLower(cmd.ui.Ask("Really delete domain %s?>", domain.Name))
+		if response != "y" && response != "yes" {
+			return
+		}
+	}
+
+	cmd.ui.Say("Deleting domain %s...", term.Cyan(domain.Name))
+
+	err = cmd.domainRepo.Delete(domain)
+	if err != nil {
+		cmd.ui.Failed("Error deleting domain", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
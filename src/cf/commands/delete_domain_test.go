@@ -0,0 +1,59 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestDeleteDomainFailsWithUsage(t *testing.T) {
+	ui, _ := deleteDomain("y", []string{})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui, _ = deleteDomain("y", []string{"example.com"})
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestDeleteDomainConfirmingWithY(t *testing.T) {
+	ui, domainRepo := deleteDomain("y", []string{"example.com"})
+
+	assert.Equal(t, domainRepo.FindByNameInCurrentOrgName, "example.com")
+	assert.Contains(t, ui.Prompts[0], "Really delete domain example.com?>")
+	assert.Contains(t, ui.Outputs[0], "Deleting domain")
+	assert.Contains(t, ui.Outputs[0], "example.com")
+	assert.Equal(t, domainRepo.DeletedDomain.Guid, "example-domain-guid")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestDeleteDomainNotConfirming(t *testing.T) {
+	ui, domainRepo := deleteDomain("n", []string{"example.com"})
+
+	assert.Contains(t, ui.Prompts[0], "Really delete domain example.com?>")
+	assert.Equal(t, len(ui.Outputs), 0)
+	assert.Equal(t, domainRepo.DeletedDomain.Guid, "")
+}
+
+func TestDeleteDomainWithForceOption(t *testing.T) {
+	ui, domainRepo := deleteDomain("", []string{"-f", "example.com"})
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Equal(t, domainRepo.DeletedDomain.Guid, "example-domain-guid")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func deleteDomain(confirmation string, args []string) (ui *testhelpers.FakeUI, domainRepo *testhelpers.FakeDomainRepository) {
+	domainRepo = &testhelpers.FakeDomainRepository{
+		FindByNameInCurrentOrgDomain: cf.Domain{Name: "example.com", Guid: "example-domain-guid"},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui = &testhelpers.FakeUI{
+		Inputs: []string{confirmation},
+	}
+	ctxt := testhelpers.NewContext("delete-domain", args)
+	cmd := NewDeleteDomain(ui, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
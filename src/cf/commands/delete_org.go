@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type DeleteOrg struct {
+	ui         term.UI
+	config     *configuration.Configuration
+	configRepo configuration.ConfigurationRepository
+	orgRepo    api.OrganizationRepository
+}
+
+func NewDeleteOrg(ui term.UI, configRepo configuration.ConfigurationRepository, orgRepo api.OrganizationRepository) (cmd *DeleteOrg) {
+	cmd = new(DeleteOrg)
+	cmd.ui = ui
+	cmd.configRepo = configRepo
+	cmd.config, _ = configRepo.Get()
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd *DeleteOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "delete-org")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *DeleteOrg) Run(c *cli.Context) {
+	org, err := cmd.orgRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding org", err)
+		return
+	}
+
+	if !c.Bool("f") {
+		summary, err := cmd.orgRepo.GetSummary(org)
+		if err != nil {
+			cmd.ui.Failed("Error getting org summary", err)
+			return
+		}
+
+		spaceNames := []string{}
+		for _, space := range summary.Spaces {
+			spaceNames = append(spaceNames, space.Name)
+		}
+
+		cmd.ui.Say("Deleting org %s will also delete:", term.Yellow(org.Name))
+		cmd.ui.Say("  spaces: %s", strings.Join(spaceNames, ", "))
+		cmd.ui.Say("  apps: %d", summary.AppCount)
+		cmd.ui.Say("  services: %d", summary.ServiceCount)
+		cmd.ui.Say("")
+
+		response := strings.ToLower(cmd.ui.Ask("Really delete org %s and everything in it?>", org.Name))
+		if response != "y" && response != "yes" {
+			return
+		}
+	}
+
+	cmd.ui.Say("Deleting org %s...", term.Cyan(org.Name))
+
+	err = cmd.orgRepo.Delete(org)
+	if err != nil {
+		cmd.ui.Failed("Error deleting org", err)
+		return
+	}
+
+	if cmd.config.Organization.Guid == org.Guid {
+		cmd.config.Organization = cf.Organization{}
+		cmd.config.Space = cf.Space{}
+		err = cmd.configRepo.Save()
+		if err != nil {
+			cmd.ui.Failed("Error saving configuration", err)
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,98 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestDeleteOrgFailsWithUsage(t *testing.T) {
+	ui, _ := deleteOrg("y", []string{})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui, _ = deleteOrg("y", []string{"my-org"})
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestDeleteOrgConfirmingWithY(t *testing.T) {
+	testhelpers.FakeConfigRepository{}.Delete()
+
+	ui, orgRepo := deleteOrg("y", []string{"my-org"})
+
+	assert.Equal(t, orgRepo.OrganizationName, "my-org")
+	assert.Contains(t, ui.Outputs[0], "Deleting org")
+	assert.Contains(t, ui.Outputs[0], "will also delete")
+	assert.Contains(t, ui.Outputs[1], "space-1, space-2")
+	assert.Contains(t, ui.Outputs[2], "apps: 3")
+	assert.Contains(t, ui.Outputs[3], "services: 2")
+	assert.Contains(t, ui.Prompts[0], "Really delete org my-org and everything in it?>")
+	assert.Contains(t, ui.Outputs[5], "Deleting org")
+	assert.Equal(t, orgRepo.DeletedOrganization.Guid, "my-org-guid")
+	assert.Contains(t, ui.Outputs[6], "OK")
+}
+
+func TestDeleteOrgNotConfirming(t *testing.T) {
+	ui, orgRepo := deleteOrg("n", []string{"my-org"})
+
+	assert.Contains(t, ui.Prompts[0], "Really delete org my-org and everything in it?>")
+	assert.Equal(t, orgRepo.DeletedOrganization.Guid, "")
+}
+
+func TestDeleteOrgWithForceOption(t *testing.T) {
+	ui, orgRepo := deleteOrg("", []string{"-f", "my-org"})
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Contains(t, ui.Outputs[0], "Deleting org")
+	assert.Equal(t, orgRepo.DeletedOrganization.Guid, "my-org-guid")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestDeleteOrgClearsTargetedOrg(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+	config, _ := configRepo.Get()
+	config.Organization = cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	config.Space = cf.Space{Name: "space-1", Guid: "space-1-guid"}
+
+	deleteOrg("", []string{"-f", "my-org"})
+
+	assert.Equal(t, testhelpers.SavedConfiguration.Organization, cf.Organization{})
+	assert.Equal(t, testhelpers.SavedConfiguration.Space, cf.Space{})
+}
+
+func TestDeleteOrgKeepsOtherTargetedOrg(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+	config, _ := configRepo.Get()
+	config.Organization = cf.Organization{Name: "other-org", Guid: "other-org-guid"}
+
+	deleteOrg("", []string{"-f", "my-org"})
+
+	config, _ = configRepo.Get()
+	assert.Equal(t, config.Organization.Guid, "other-org-guid")
+}
+
+func deleteOrg(confirmation string, args []string) (ui *testhelpers.FakeUI, orgRepo *testhelpers.FakeOrgRepository) {
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	orgRepo = &testhelpers.FakeOrgRepository{
+		OrganizationByName: org,
+		SummaryOrganization: cf.Organization{
+			Name:         "my-org",
+			Guid:         "my-org-guid",
+			Spaces:       []cf.Space{cf.Space{Name: "space-1"}, cf.Space{Name: "space-2"}},
+			AppCount:     3,
+			ServiceCount: 2,
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui = &testhelpers.FakeUI{
+		Inputs: []string{confirmation},
+	}
+	ctxt := testhelpers.NewContext("delete-org", args)
+	cmd := NewDeleteOrg(ui, testhelpers.FakeConfigRepository{}, orgRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
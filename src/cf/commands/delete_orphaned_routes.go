@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type DeleteOrphanedRoutes struct {
+	ui        term.UI
+	config    *configuration.Configuration
+	routeRepo api.RouteRepository
+}
+
+func NewDeleteOrphanedRoutes(ui term.UI, config *configuration.Configuration, routeRepo api.RouteRepository) (cmd *DeleteOrphanedRoutes) {
+	cmd = new(DeleteOrphanedRoutes)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.routeRepo = routeRepo
+	return
+}
+
+func (cmd *DeleteOrphanedRoutes) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+	}
+	return
+}
+
+func (cmd *DeleteOrphanedRoutes) Run(c *cli.Context) {
+	cmd.ui.Say("Getting routes in space %s...", term.Cyan(cmd.config.Space.Name))
+
+	routes, err := cmd.routeRepo.FindAllInCurrentSpace()
+	if err != nil {
+		cmd.ui.Failed("Error getting routes", err)
+		return
+	}
+
+	orphans := []cf.Route{}
+	for _, route := range routes {
+		if len(route.AppNames) == 0 {
+			orphans = append(orphans, route)
+		}
+	}
+
+	cmd.ui.Ok()
+
+	if len(orphans) == 0 {
+		cmd.ui.Say("No orphaned routes found")
+		return
+	}
+
+	cmd.ui.Say("Routes not bound to any app:")
+	for _, route := range orphans {
+		cmd.ui.Say("  %s", route.URL())
+	}
+
+	if !c.Bool("f") {
+		response := strings.ToLower(cmd.ui.Ask("Really delete %d orphaned routes?>", len(orphans)))
+		if response != "y" && response != "yes" {
+			return
+		}
+	}
+
+	cmd.ui.Say("Deleting orphaned routes...")
+
+	errs := cmd.deleteRoutes(orphans)
+
+	failures := 0
+	for i, route := range orphans {
+		if errs[i] != nil {
+			failures++
+			cmd.ui.Say("  %s: %s", term.Red(route.URL()), errs[i].Error())
+		} else {
+			cmd.ui.Say("  %s deleted", route.URL())
+		}
+	}
+
+	if failures > 0 {
+		message := fmt.Sprintf("Deleted %d routes, %d could not be deleted", len(orphans)-failures, failures)
+		cmd.ui.Failed(message, nil)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("Deleted %d routes", len(orphans))
+}
+
+// maxConcurrentRouteDeletes bounds the requests in flight, so a space with
+// many orphans does not flood Cloud Controller.
+const maxConcurrentRouteDeletes = 5
+
+// deleteRoutes deletes the routes a few at a time and returns their errors
+// in the same order as the routes.
+func (cmd *DeleteOrphanedRoutes) deleteRoutes(routes []cf.Route) (errs []error) {
+	type result struct {
+		index int
+		err   error
+	}
+
+	results := make(chan result, len(routes))
+	slots := make(chan bool, maxConcurrentRouteDeletes)
+	for i, route := range routes {
+		slots <- true
+		go func(index int, route cf.Route) {
+			defer func() { <-slots }()
+			results <- result{index, cmd.routeRepo.Delete(route)}
+		}(i, route)
+	}
+
+	errs = make([]error, len(routes))
+	for _ = range routes {
+		r := <-results
+		errs[r.index] = r.err
+	}
+	return
+}
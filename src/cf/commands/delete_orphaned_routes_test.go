@@ -0,0 +1,148 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testhelpers"
+	"testing"
+)
+
+func TestDeleteOrphanedRoutesRequirements(t *testing.T) {
+	routeRepo := &testhelpers.FakeRouteRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	callDeleteOrphanedRoutes("y", []string{}, reqFactory, routeRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: false}
+	callDeleteOrphanedRoutes("y", []string{}, reqFactory, routeRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestDeleteOrphanedRoutesConfirmingWithY(t *testing.T) {
+	routeRepo := &testhelpers.FakeRouteRepository{FindAllInCurrentSpaceRoutes: routesWithOrphans()}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callDeleteOrphanedRoutes("y", []string{}, reqFactory, routeRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Getting routes in space")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[2], "Routes not bound to any app")
+	assert.Contains(t, ui.Outputs[3], "orphan-1.example.com")
+	assert.Contains(t, ui.Outputs[4], "orphan-2.example.com")
+	assert.Contains(t, ui.Prompts[0], "Really delete 2 orphaned routes?>")
+
+	assert.Equal(t, len(routeRepo.DeletedRoutes), 2)
+	for _, route := range routeRepo.DeletedRoutes {
+		assert.NotEqual(t, route.Guid, "in-use-guid")
+	}
+
+	assert.Contains(t, ui.Outputs[5], "Deleting orphaned routes")
+	assert.Contains(t, ui.Outputs[6], "orphan-1.example.com deleted")
+	assert.Contains(t, ui.Outputs[7], "orphan-2.example.com deleted")
+	assert.Contains(t, ui.Outputs[8], "OK")
+	assert.Contains(t, ui.Outputs[9], "Deleted 2 routes")
+}
+
+func TestDeleteOrphanedRoutesNotConfirming(t *testing.T) {
+	routeRepo := &testhelpers.FakeRouteRepository{FindAllInCurrentSpaceRoutes: routesWithOrphans()}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callDeleteOrphanedRoutes("n", []string{}, reqFactory, routeRepo)
+
+	assert.Equal(t, len(ui.Prompts), 1)
+	assert.Equal(t, len(routeRepo.DeletedRoutes), 0)
+}
+
+func TestDeleteOrphanedRoutesWithForceOption(t *testing.T) {
+	routeRepo := &testhelpers.FakeRouteRepository{FindAllInCurrentSpaceRoutes: routesWithOrphans()}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callDeleteOrphanedRoutes("", []string{"-f"}, reqFactory, routeRepo)
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Equal(t, len(routeRepo.DeletedRoutes), 2)
+}
+
+func TestDeleteOrphanedRoutesWhenThereAreNone(t *testing.T) {
+	routes := []cf.Route{
+		cf.Route{Host: "in-use", Guid: "in-use-guid", AppNames: []string{"my-app"}},
+	}
+	routeRepo := &testhelpers.FakeRouteRepository{FindAllInCurrentSpaceRoutes: routes}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callDeleteOrphanedRoutes("y", []string{}, reqFactory, routeRepo)
+
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[2], "No orphaned routes found")
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Equal(t, len(routeRepo.DeletedRoutes), 0)
+}
+
+func TestDeleteOrphanedRoutesReportsFailures(t *testing.T) {
+	routeRepo := &testhelpers.FakeRouteRepository{
+		FindAllInCurrentSpaceRoutes: routesWithOrphans(),
+		DeleteErrGuid:               "orphan-1-guid",
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callDeleteOrphanedRoutes("", []string{"-f"}, reqFactory, routeRepo)
+
+	assert.Equal(t, len(routeRepo.DeletedRoutes), 1)
+	assert.Equal(t, routeRepo.DeletedRoutes[0].Guid, "orphan-2-guid")
+
+	assert.Contains(t, ui.Outputs[6], "orphan-1.example.com")
+	assert.Contains(t, ui.Outputs[6], "Error deleting route")
+	assert.Contains(t, ui.Outputs[7], "orphan-2.example.com deleted")
+	assert.Contains(t, ui.Outputs[8], "FAILED")
+	assert.Contains(t, ui.Outputs[9], "Deleted 1 routes, 1 could not be deleted")
+}
+
+func TestDeleteOrphanedRoutesWithManyOrphans(t *testing.T) {
+	domain := cf.Domain{Name: "example.com", Guid: "example-domain-guid"}
+	routes := []cf.Route{}
+	for i := 0; i < 20; i++ {
+		host := fmt.Sprintf("orphan-%d", i)
+		routes = append(routes, cf.Route{Host: host, Guid: host + "-guid", Domain: domain})
+	}
+
+	routeRepo := &testhelpers.FakeRouteRepository{
+		FindAllInCurrentSpaceRoutes: routes,
+		DeleteErrGuid:               "orphan-7-guid",
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callDeleteOrphanedRoutes("", []string{"-f"}, reqFactory, routeRepo)
+
+	assert.Equal(t, len(routeRepo.DeletedRoutes), 19)
+	assert.Contains(t, ui.Outputs[30], "orphan-6.example.com deleted")
+	assert.Contains(t, ui.Outputs[31], "Error deleting route")
+	assert.Contains(t, ui.Outputs[32], "orphan-8.example.com deleted")
+	assert.Contains(t, strings.Join(ui.Outputs, "\n"), "Deleted 19 routes, 1 could not be deleted")
+}
+
+func routesWithOrphans() []cf.Route {
+	domain := cf.Domain{Name: "example.com", Guid: "example-domain-guid"}
+	return []cf.Route{
+		cf.Route{Host: "orphan-1", Guid: "orphan-1-guid", Domain: domain},
+		cf.Route{Host: "in-use", Guid: "in-use-guid", Domain: domain, AppNames: []string{"my-app"}},
+		cf.Route{Host: "orphan-2", Guid: "orphan-2-guid", Domain: domain},
+	}
+}
+
+func callDeleteOrphanedRoutes(confirmation string, args []string, reqFactory *testhelpers.FakeReqFactory, routeRepo *testhelpers.FakeRouteRepository) (ui *testhelpers.FakeUI) {
+	ui = &testhelpers.FakeUI{
+		Inputs: []string{confirmation},
+	}
+	config := &configuration.Configuration{
+		Space: cf.Space{Name: "my-space", Guid: "my-space-guid"},
+	}
+	ctxt := testhelpers.NewContext("delete-orphaned-routes", args)
+	cmd := NewDeleteOrphanedRoutes(ui, config, routeRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type DeleteRoute struct {
+	ui         term.UI
+	routeRepo  api.RouteRepository
+	domainRepo api.DomainRepository
+}
+
+func NewDeleteRoute(ui term.UI, routeRepo api.RouteRepository, domainRepo api.DomainRepository) (cmd *DeleteRoute) {
+	cmd = new(DeleteRoute)
+	cmd.ui = ui
+	cmd.routeRepo = routeRepo
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *DeleteRoute) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 || c.String("n") == "" {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "delete-route")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+	}
+	return
+}
+
+func (cmd *DeleteRoute) Run(c *cli.Context) {
+	domain, err := cmd.domainRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding domain", err)
+		return
+	}
+
+	route, err := cmd.routeRepo.FindByHostAndDomain(c.String("n"), domain)
+	if err != nil {
+		requestedRoute := cf.Route{Host: c.String("n"), Domain: domain}
+		cmd.ui.Failed(fmt.Sprintf("Error finding route %s", requestedRoute.URL()), err)
+		return
+	}
+
+	if !c.Bool("f") {
+		response := strings.ToLower(cmd.ui.Ask("Really delete route %s?>", route.URL()))
+		if response != "y" && response != "yes" {
+			return
+		}
+	}
+
+	cmd.ui.Say("Deleting route %s...", term.Cyan(route.URL()))
+
+	err = cmd.routeRepo.Delete(route)
+	if err != nil {
+		cmd.ui.Failed("Error deleting route", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,82 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestDeleteRouteFailsWithUsage(t *testing.T) {
+	ui, _ := deleteRoute("y", []string{"example.com"})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui, _ = deleteRoute("y", []string{"-n", "my-host"})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui, _ = deleteRoute("y", []string{"-n", "my-host", "example.com"})
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestDeleteRouteConfirmingWithY(t *testing.T) {
+	ui, routeRepo := deleteRoute("y", []string{"-n", "my-host", "example.com"})
+
+	assert.Contains(t, ui.Prompts[0], "Really delete route my-host.example.com?>")
+	assert.Contains(t, ui.Outputs[0], "Deleting route")
+	assert.Contains(t, ui.Outputs[0], "my-host.example.com")
+	assert.Equal(t, routeRepo.DeletedRoute.Guid, "my-host-guid")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestDeleteRouteNotConfirming(t *testing.T) {
+	ui, routeRepo := deleteRoute("n", []string{"-n", "my-host", "example.com"})
+
+	assert.Contains(t, ui.Prompts[0], "Really delete route my-host.example.com?>")
+	assert.Equal(t, len(ui.Outputs), 0)
+	assert.Equal(t, routeRepo.DeletedRoute.Guid, "")
+}
+
+func TestDeleteRouteWithForceOption(t *testing.T) {
+	ui, routeRepo := deleteRoute("", []string{"-f", "-n", "my-host", "example.com"})
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Contains(t, ui.Outputs[0], "Deleting route")
+	assert.Equal(t, routeRepo.DeletedRoute.Guid, "my-host-guid")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestDeleteRouteWhenRouteDoesNotExist(t *testing.T) {
+	domain := cf.Domain{Name: "example.com", Guid: "example-domain-guid"}
+	routeRepo := &testhelpers.FakeRouteRepository{FindByHostAndDomainErr: true}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := &testhelpers.FakeUI{}
+	ctxt := testhelpers.NewContext("delete-route", []string{"-n", "other-host", "example.com"})
+	cmd := NewDeleteRoute(ui, routeRepo, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+
+	assert.Equal(t, routeRepo.FindByHostAndDomainHost, "other-host")
+	assert.Equal(t, routeRepo.FindByHostAndDomainDomain, domain)
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Error finding route other-host.example.com")
+	assert.Equal(t, routeRepo.DeletedRoute.Guid, "")
+}
+
+func deleteRoute(confirmation string, args []string) (ui *testhelpers.FakeUI, routeRepo *testhelpers.FakeRouteRepository) {
+	domain := cf.Domain{Name: "example.com", Guid: "example-domain-guid"}
+	routeRepo = &testhelpers.FakeRouteRepository{
+		FindByHostAndDomainRoute: cf.Route{Host: "my-host", Guid: "my-host-guid", Domain: domain},
+	}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui = &testhelpers.FakeUI{
+		Inputs: []string{confirmation},
+	}
+	ctxt := testhelpers.NewContext("delete-route", args)
+	cmd := NewDeleteRoute(ui, routeRepo, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"cf/api"
+	"cf/configuration"
 	"cf/requirements"
 	term "cf/terminal"
 	"errors"
@@ -11,13 +12,15 @@ import (
 type DeleteService struct {
 	ui                 term.UI
 	serviceRepo        api.ServiceRepository
+	waiter             serviceOperationWaiter
 	serviceInstanceReq requirements.ServiceInstanceRequirement
 }
 
-func NewDeleteService(ui term.UI, sR api.ServiceRepository) (cmd *DeleteService) {
+func NewDeleteService(ui term.UI, config *configuration.Configuration, sR api.ServiceRepository) (cmd *DeleteService) {
 	cmd = new(DeleteService)
 	cmd.ui = ui
 	cmd.serviceRepo = sR
+	cmd.waiter = newServiceOperationWaiter(ui, config, sR)
 	return
 }
 
@@ -41,10 +44,22 @@ func (cmd *DeleteService) GetRequirements(reqFactory requirements.Factory, c *cl
 }
 
 func (cmd *DeleteService) Run(c *cli.Context) {
+	wait, err := serviceWaitOption(c)
+	if err != nil {
+		cmd.ui.Failed("Error reading service options", err)
+		return
+	}
+
 	instance := cmd.serviceInstanceReq.GetServiceInstance()
 	cmd.ui.Say("Deleting service %s...", term.Cyan(instance.Name))
 
-	err := cmd.serviceRepo.DeleteService(instance)
+	err = cmd.serviceRepo.DeleteService(instance)
+	if err != nil {
+		cmd.ui.Failed("Failed deleting service", err)
+		return
+	}
+
+	err = cmd.waiter.Follow(instance, "delete", wait)
 	if err != nil {
 		cmd.ui.Failed("Failed deleting service", err)
 		return
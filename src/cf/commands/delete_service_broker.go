@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type DeleteServiceBroker struct {
+	ui         term.UI
+	brokerRepo api.ServiceBrokerRepository
+}
+
+func NewDeleteServiceBroker(ui term.UI, brokerRepo api.ServiceBrokerRepository) (cmd *DeleteServiceBroker) {
+	cmd = new(DeleteServiceBroker)
+	cmd.ui = ui
+	cmd.brokerRepo = brokerRepo
+	return
+}
+
+func (cmd *DeleteServiceBroker) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "delete-service-broker")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *DeleteServiceBroker) Run(c *cli.Context) {
+	broker, err := cmd.brokerRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding service broker", err)
+		return
+	}
+
+	if !c.Bool("f") {
+		response := strings.ToLower(cmd.ui.Ask("Really delete service broker %s?>", broker.Name))
+		if response != "y" && response != "yes" {
+			return
+		}
+	}
+
+	cmd.ui.Say("Deleting service broker %s...", term.Cyan(broker.Name))
+
+	err = cmd.brokerRepo.Delete(broker)
+	if err != nil {
+		cmd.ui.Failed("Error deleting service broker", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
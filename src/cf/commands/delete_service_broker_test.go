@@ -0,0 +1,73 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestDeleteServiceBrokerFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{}
+
+	ui := callDeleteServiceBroker([]string{}, []string{}, reqFactory, brokerRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callDeleteServiceBroker([]string{"-f", "my-broker"}, []string{}, reqFactory, brokerRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestDeleteServiceBrokerRequirements(t *testing.T) {
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callDeleteServiceBroker([]string{"-f", "my-broker"}, []string{}, reqFactory, brokerRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestDeleteServiceBrokerWithConfirmation(t *testing.T) {
+	broker := cf.ServiceBroker{Name: "my-broker", Guid: "my-broker-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{FindByNameServiceBroker: broker}
+
+	ui := callDeleteServiceBroker([]string{"my-broker"}, []string{"y"}, reqFactory, brokerRepo)
+
+	assert.Equal(t, brokerRepo.FindByNameName, "my-broker")
+	assert.Contains(t, ui.Prompts[0], "Really delete service broker my-broker")
+	assert.Contains(t, ui.Outputs[0], "Deleting service broker")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, brokerRepo.DeletedServiceBroker, broker)
+}
+
+func TestDeleteServiceBrokerWhenNotConfirmed(t *testing.T) {
+	broker := cf.ServiceBroker{Name: "my-broker", Guid: "my-broker-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{FindByNameServiceBroker: broker}
+
+	ui := callDeleteServiceBroker([]string{"my-broker"}, []string{"n"}, reqFactory, brokerRepo)
+
+	assert.Equal(t, len(ui.Outputs), 0)
+	assert.Equal(t, brokerRepo.DeletedServiceBroker, cf.ServiceBroker{})
+}
+
+func TestDeleteServiceBrokerWithForce(t *testing.T) {
+	broker := cf.ServiceBroker{Name: "my-broker", Guid: "my-broker-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{FindByNameServiceBroker: broker}
+
+	ui := callDeleteServiceBroker([]string{"-f", "my-broker"}, []string{}, reqFactory, brokerRepo)
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, brokerRepo.DeletedServiceBroker, broker)
+}
+
+func callDeleteServiceBroker(args []string, inputs []string, reqFactory *testhelpers.FakeReqFactory, brokerRepo *testhelpers.FakeServiceBrokerRepo) (ui *testhelpers.FakeUI) {
+	ui = &testhelpers.FakeUI{Inputs: inputs}
+	ctxt := testhelpers.NewContext("delete-service-broker", args)
+	cmd := NewDeleteServiceBroker(ui, brokerRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type DeleteServiceKey struct {
+	ui                 term.UI
+	serviceRepo        api.ServiceRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewDeleteServiceKey(ui term.UI, serviceRepo api.ServiceRepository) (cmd *DeleteServiceKey) {
+	cmd = new(DeleteServiceKey)
+	cmd.ui = ui
+	cmd.serviceRepo = serviceRepo
+	return
+}
+
+func (cmd *DeleteServiceKey) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "delete-service-key")
+		return
+	}
+
+	cmd.serviceInstanceReq = reqFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return
+}
+
+func (cmd *DeleteServiceKey) Run(c *cli.Context) {
+	instance := cmd.serviceInstanceReq.GetServiceInstance()
+	keyName := c.Args()[1]
+
+	if !c.Bool("f") {
+		response := strings.ToLower(cmd.ui.Ask("Really delete key %s of service instance %s?>", keyName, instance.Name))
+		if response != "y" && response != "yes" {
+			return
+		}
+	}
+
+	cmd.ui.Say("Deleting key %s for service instance %s...", term.Cyan(keyName), term.Cyan(instance.Name))
+
+	key, err := cmd.serviceRepo.FindServiceKey(instance, keyName)
+	if err != nil {
+		cmd.ui.Failed("Error finding service key", err)
+		return
+	}
+
+	err = cmd.serviceRepo.DeleteServiceKey(key)
+	if err != nil {
+		cmd.ui.Failed("Error deleting service key", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
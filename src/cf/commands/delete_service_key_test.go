@@ -0,0 +1,64 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestDeleteServiceKeyFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callDeleteServiceKey([]string{"my-service"}, []string{}, reqFactory, serviceRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callDeleteServiceKey([]string{"-f", "my-service", "my-key"}, []string{}, reqFactory, serviceRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestDeleteServiceKeyWithConfirmation(t *testing.T) {
+	instance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	key := cf.ServiceKey{Name: "my-key", Guid: "my-key-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: instance}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceKeys: []cf.ServiceKey{key}}
+
+	ui := callDeleteServiceKey([]string{"my-service", "my-key"}, []string{"y"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Prompts[0], "Really delete key my-key of service instance my-service")
+	assert.Contains(t, ui.Outputs[0], "Deleting key")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, serviceRepo.DeletedServiceKey, key)
+}
+
+func TestDeleteServiceKeyWhenNotConfirmed(t *testing.T) {
+	instance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: instance}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceKeys: []cf.ServiceKey{{Name: "my-key", Guid: "my-key-guid"}}}
+
+	ui := callDeleteServiceKey([]string{"my-service", "my-key"}, []string{"n"}, reqFactory, serviceRepo)
+
+	assert.Equal(t, len(ui.Outputs), 0)
+	assert.Equal(t, serviceRepo.DeletedServiceKey, cf.ServiceKey{})
+}
+
+func TestDeleteServiceKeyWhenKeyIsNotFound(t *testing.T) {
+	instance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: instance}
+	serviceRepo := &testhelpers.FakeServiceRepo{FindServiceKeyNotFound: true}
+
+	ui := callDeleteServiceKey([]string{"-f", "my-service", "my-key"}, []string{}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[1], "FAILED")
+	assert.Equal(t, serviceRepo.DeletedServiceKey, cf.ServiceKey{})
+}
+
+func callDeleteServiceKey(args []string, inputs []string, reqFactory *testhelpers.FakeReqFactory, serviceRepo *testhelpers.FakeServiceRepo) (ui *testhelpers.FakeUI) {
+	ui = &testhelpers.FakeUI{Inputs: inputs}
+	ctxt := testhelpers.NewContext("delete-service-key", args)
+	cmd := NewDeleteServiceKey(ui, serviceRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
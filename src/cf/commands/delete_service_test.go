@@ -4,6 +4,7 @@ import (
 	"cf"
 	"cf/api"
 	. "cf/commands"
+	"cf/configuration"
 	"github.com/stretchr/testify/assert"
 	"testhelpers"
 	"testing"
@@ -35,10 +36,29 @@ func TestDeleteServiceCommandFailsWithUsage(t *testing.T) {
 	assert.False(t, fakeUI.FailedWithUsage)
 }
 
+func TestDeleteServiceWaitsUntilInstanceIsGone(t *testing.T) {
+	serviceInstance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{ServiceInstance: serviceInstance}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		GetServiceInstanceResponses: []cf.ServiceInstance{
+			{LastOperation: cf.LastOperation{Type: "delete", State: "in progress"}},
+			{},
+		},
+		GetServiceInstanceErrorCodes: []int{0, 60004},
+	}
+	fakeUI := callDeleteService([]string{"my-service"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, fakeUI.Outputs[0], "Deleting service")
+	assert.Contains(t, fakeUI.Outputs[1], "delete in progress")
+	assert.Contains(t, fakeUI.Outputs[2], "OK")
+	assert.Equal(t, serviceRepo.GetServiceInstanceGuids, []string{"my-service-guid", "my-service-guid"})
+}
+
 func callDeleteService(args []string, reqFactory *testhelpers.FakeReqFactory, serviceRepo api.ServiceRepository) (fakeUI *testhelpers.FakeUI) {
 	fakeUI = new(testhelpers.FakeUI)
 	ctxt := testhelpers.NewContext("delete-service", args)
-	cmd := NewDeleteService(fakeUI, serviceRepo)
+	config := &configuration.Configuration{ServiceOperationTimeout: 300}
+	cmd := NewDeleteService(fakeUI, config, serviceRepo)
 	testhelpers.RunCommand(cmd, ctxt, reqFactory)
 	return
 }
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type DeleteSpace struct {
+	ui         term.UI
+	config     *configuration.Configuration
+	configRepo configuration.ConfigurationRepository
+	spaceRepo  api.SpaceRepository
+}
+
+func NewDeleteSpace(ui term.UI, configRepo configuration.ConfigurationRepository, spaceRepo api.SpaceRepository) (cmd *DeleteSpace) {
+	cmd = new(DeleteSpace)
+	cmd.ui = ui
+	cmd.configRepo = configRepo
+	cmd.config, _ = configRepo.Get()
+	cmd.spaceRepo = spaceRepo
+	return
+}
+
+func (cmd *DeleteSpace) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "delete-space")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewOrganizationRequirement(),
+	}
+	return
+}
+
+func (cmd *DeleteSpace) Run(c *cli.Context) {
+	space, err := cmd.spaceRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding space", err)
+		return
+	}
+
+	if !c.Bool("f") {
+		summary, err := cmd.spaceRepo.GetSpaceSummary(space)
+		if err != nil {
+			cmd.ui.Failed("Error getting space summary", err)
+			return
+		}
+
+		appNames := []string{}
+		for _, app := range summary.Applications {
+			appNames = append(appNames, app.Name)
+		}
+
+		serviceNames := []string{}
+		for _, instance := range summary.ServiceInstances {
+			serviceNames = append(serviceNames, instance.Name)
+		}
+
+		cmd.ui.Say("Deleting space %s will also delete:", term.Yellow(space.Name))
+		cmd.ui.Say("  apps: %s", strings.Join(appNames, ", "))
+		cmd.ui.Say("  services: %s", strings.Join(serviceNames, ", "))
+		cmd.ui.Say("")
+
+		response := strings.ToLower(cmd.ui.Ask("Really delete space %s and everything in it?>", space.Name))
+		if response != "y" && response != "yes" {
+			return
+		}
+	}
+
+	cmd.ui.Say("Deleting space %s in org %s...", term.Cyan(space.Name), term.Cyan(cmd.config.Organization.Name))
+
+	err = cmd.spaceRepo.Delete(space)
+	if err != nil {
+		cmd.ui.Failed("Error deleting space", err)
+		return
+	}
+
+	if cmd.config.Space.Guid == space.Guid {
+		cmd.config.Space = cf.Space{}
+		err = cmd.configRepo.Save()
+		if err != nil {
+			cmd.ui.Failed("Error saving configuration", err)
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+}
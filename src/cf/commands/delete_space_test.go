@@ -0,0 +1,83 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestDeleteSpaceFailsWithUsage(t *testing.T) {
+	ui, _ := deleteSpace("y", []string{})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui, _ = deleteSpace("y", []string{"my-space"})
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestDeleteSpaceConfirmingWithY(t *testing.T) {
+	testhelpers.FakeConfigRepository{}.Delete()
+
+	ui, spaceRepo := deleteSpace("y", []string{"my-space"})
+
+	assert.Equal(t, spaceRepo.SpaceName, "my-space")
+	assert.Contains(t, ui.Outputs[0], "Deleting space")
+	assert.Contains(t, ui.Outputs[0], "will also delete")
+	assert.Contains(t, ui.Outputs[1], "app-1, app-2")
+	assert.Contains(t, ui.Outputs[2], "my-service")
+	assert.Contains(t, ui.Prompts[0], "Really delete space my-space and everything in it?>")
+	assert.Contains(t, ui.Outputs[4], "Deleting space")
+	assert.Equal(t, spaceRepo.DeletedSpace.Guid, "my-space-guid")
+	assert.Contains(t, ui.Outputs[5], "OK")
+}
+
+func TestDeleteSpaceNotConfirming(t *testing.T) {
+	ui, spaceRepo := deleteSpace("n", []string{"my-space"})
+
+	assert.Contains(t, ui.Prompts[0], "Really delete space my-space and everything in it?>")
+	assert.Equal(t, spaceRepo.DeletedSpace.Guid, "")
+}
+
+func TestDeleteSpaceWithForceOption(t *testing.T) {
+	ui, spaceRepo := deleteSpace("", []string{"-f", "my-space"})
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Contains(t, ui.Outputs[0], "Deleting space")
+	assert.Equal(t, spaceRepo.DeletedSpace.Guid, "my-space-guid")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestDeleteSpaceClearsTargetedSpace(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+	config, _ := configRepo.Get()
+	config.Organization = cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	config.Space = cf.Space{Name: "my-space", Guid: "my-space-guid"}
+
+	deleteSpace("", []string{"-f", "my-space"})
+
+	assert.Equal(t, testhelpers.SavedConfiguration.Organization.Guid, "my-org-guid")
+	assert.Equal(t, testhelpers.SavedConfiguration.Space, cf.Space{})
+}
+
+func deleteSpace(confirmation string, args []string) (ui *testhelpers.FakeUI, spaceRepo *testhelpers.FakeSpaceRepository) {
+	spaceRepo = &testhelpers.FakeSpaceRepository{
+		SpaceByName: cf.Space{Name: "my-space", Guid: "my-space-guid"},
+		SpaceSummarySpace: cf.Space{
+			Name:             "my-space",
+			Guid:             "my-space-guid",
+			Applications:     []cf.Application{cf.Application{Name: "app-1"}, cf.Application{Name: "app-2"}},
+			ServiceInstances: []cf.ServiceInstance{cf.ServiceInstance{Name: "my-service"}},
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui = &testhelpers.FakeUI{
+		Inputs: []string{confirmation},
+	}
+	ctxt := testhelpers.NewContext("delete-space", args)
+	cmd := NewDeleteSpace(ui, testhelpers.FakeConfigRepository{}, spaceRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
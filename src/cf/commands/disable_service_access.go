@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+)
+
+type DisableServiceAccess struct {
+	ui             term.UI
+	serviceRepo    api.ServiceRepository
+	visibilityRepo api.ServicePlanVisibilityRepository
+	orgRepo        api.OrganizationRepository
+}
+
+func NewDisableServiceAccess(ui term.UI, serviceRepo api.ServiceRepository, visibilityRepo api.ServicePlanVisibilityRepository, orgRepo api.OrganizationRepository) (cmd *DisableServiceAccess) {
+	cmd = new(DisableServiceAccess)
+	cmd.ui = ui
+	cmd.serviceRepo = serviceRepo
+	cmd.visibilityRepo = visibilityRepo
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd *DisableServiceAccess) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "disable-service-access")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *DisableServiceAccess) Run(c *cli.Context) {
+	label := c.Args()[0]
+	planName := c.String("p")
+	orgName := c.String("o")
+
+	plans, err := findServiceAccessPlans(cmd.serviceRepo, label, planName)
+	if err != nil {
+		cmd.ui.Failed("Error finding service plans", err)
+		return
+	}
+
+	visibilities, err := cmd.visibilityRepo.FindAll()
+	if err != nil {
+		cmd.ui.Failed("Error loading service plan visibilities", err)
+		return
+	}
+
+	if orgName == "" {
+		cmd.ui.Say("Disabling access to %s for all orgs...", serviceAccessDescription(label, planName))
+
+		for _, plan := range plans {
+			if plan.Public {
+				err = cmd.serviceRepo.SetServicePlanPublic(plan, false)
+				if err != nil {
+					cmd.ui.Failed("Error disabling service access", err)
+					return
+				}
+			}
+
+			for _, visibility := range visibilitiesOfPlan(visibilities, plan) {
+				err = cmd.visibilityRepo.Delete(visibility)
+				if err != nil {
+					cmd.ui.Failed("Error disabling service access", err)
+					return
+				}
+			}
+		}
+
+		cmd.ui.Ok()
+		return
+	}
+
+	org, err := cmd.orgRepo.FindByName(orgName)
+	if err != nil {
+		cmd.ui.Failed("Error finding organization", err)
+		return
+	}
+
+	// A public plan cannot leave out a single org, it has to be made
+	// private first and then enabled for the orgs that keep it.
+	for _, plan := range plans {
+		if plan.Public {
+			err = errors.New(fmt.Sprintf("Plan %s is available to all orgs, disable it for all orgs first", plan.Name))
+			cmd.ui.Failed("Error disabling service access", err)
+			return
+		}
+	}
+
+	cmd.ui.Say("Disabling access to %s for org %s...", serviceAccessDescription(label, planName), term.Cyan(org.Name))
+
+	for _, plan := range plans {
+		for _, visibility := range visibilitiesOfPlan(visibilities, plan) {
+			if visibility.OrganizationGuid != org.Guid {
+				continue
+			}
+
+			err = cmd.visibilityRepo.Delete(visibility)
+			if err != nil {
+				cmd.ui.Failed("Error disabling service access", err)
+				return
+			}
+		}
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,90 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+var serviceAccessVisibilities = []cf.ServicePlanVisibility{
+	cf.ServicePlanVisibility{Guid: "visibility-1-guid", ServicePlanGuid: "limited-plan-guid", OrganizationGuid: "org-1-guid"},
+	cf.ServicePlanVisibility{Guid: "visibility-2-guid", ServicePlanGuid: "limited-plan-guid", OrganizationGuid: "org-2-guid"},
+}
+
+func TestDisableServiceAccessFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: []cf.ServiceOffering{serviceAccessOffering}}
+	visibilityRepo := &testhelpers.FakeServicePlanVisibilityRepo{}
+	orgRepo := &testhelpers.FakeOrgRepository{}
+
+	ui := callDisableServiceAccess([]string{}, reqFactory, serviceRepo, visibilityRepo, orgRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callDisableServiceAccess([]string{"my-service"}, reqFactory, serviceRepo, visibilityRepo, orgRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestDisableServiceAccessRequirements(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	callDisableServiceAccess([]string{"my-service"}, reqFactory, serviceRepo, &testhelpers.FakeServicePlanVisibilityRepo{}, &testhelpers.FakeOrgRepository{})
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestDisableServiceAccessForAllOrgs(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: []cf.ServiceOffering{serviceAccessOffering}}
+	visibilityRepo := &testhelpers.FakeServicePlanVisibilityRepo{Visibilities: serviceAccessVisibilities}
+
+	ui := callDisableServiceAccess([]string{"my-service"}, reqFactory, serviceRepo, visibilityRepo, &testhelpers.FakeOrgRepository{})
+
+	assert.Contains(t, ui.Outputs[0], "Disabling access to all plans of service")
+	assert.Contains(t, ui.Outputs[0], "for all orgs")
+	assert.Equal(t, len(serviceRepo.SetServicePlanPublicPlans), 1)
+	assert.Equal(t, serviceRepo.SetServicePlanPublicPlans[0].Name, "public-plan")
+	assert.Equal(t, serviceRepo.SetServicePlanPublicValues, []bool{false})
+	assert.Equal(t, visibilityRepo.DeletedVisibilities, serviceAccessVisibilities)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestDisableServiceAccessForOneOrg(t *testing.T) {
+	org := cf.Organization{Name: "org-2", Guid: "org-2-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: []cf.ServiceOffering{serviceAccessOffering}}
+	visibilityRepo := &testhelpers.FakeServicePlanVisibilityRepo{Visibilities: serviceAccessVisibilities}
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByName: org}
+
+	ui := callDisableServiceAccess([]string{"-p", "limited-plan", "-o", "org-2", "my-service"}, reqFactory, serviceRepo, visibilityRepo, orgRepo)
+
+	assert.Equal(t, orgRepo.OrganizationName, "org-2")
+	assert.Contains(t, ui.Outputs[0], "Disabling access to plan")
+	assert.Contains(t, ui.Outputs[0], "for org")
+	assert.Equal(t, len(serviceRepo.SetServicePlanPublicPlans), 0)
+	assert.Equal(t, visibilityRepo.DeletedVisibilities, []cf.ServicePlanVisibility{serviceAccessVisibilities[1]})
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestDisableServiceAccessForOneOrgWhenPlanIsPublic(t *testing.T) {
+	org := cf.Organization{Name: "org-1", Guid: "org-1-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: []cf.ServiceOffering{serviceAccessOffering}}
+	visibilityRepo := &testhelpers.FakeServicePlanVisibilityRepo{Visibilities: serviceAccessVisibilities}
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByName: org}
+
+	ui := callDisableServiceAccess([]string{"-o", "org-1", "my-service"}, reqFactory, serviceRepo, visibilityRepo, orgRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "Plan public-plan is available to all orgs")
+	assert.Equal(t, len(visibilityRepo.DeletedVisibilities), 0)
+}
+
+func callDisableServiceAccess(args []string, reqFactory *testhelpers.FakeReqFactory, serviceRepo *testhelpers.FakeServiceRepo, visibilityRepo *testhelpers.FakeServicePlanVisibilityRepo, orgRepo *testhelpers.FakeOrgRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("disable-service-access", args)
+	cmd := NewDisableServiceAccess(ui, serviceRepo, visibilityRepo, orgRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type Domains struct {
+	ui         term.UI
+	config     *configuration.Configuration
+	domainRepo api.DomainRepository
+}
+
+func NewDomains(ui term.UI, config *configuration.Configuration, domainRepo api.DomainRepository) (cmd *Domains) {
+	cmd = new(Domains)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *Domains) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewOrganizationRequirement(),
+	}
+	return
+}
+
+func (cmd *Domains) Run(c *cli.Context) {
+	cmd.ui.Say("Getting domains in org %s...", term.Cyan(cmd.config.Organization.Name))
+
+	domains, err := cmd.domainRepo.FindAllInCurrentOrg()
+	if err != nil {
+		cmd.ui.Failed("Error getting domains", err)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	if len(domains) == 0 {
+		cmd.ui.Say("No domains found")
+		return
+	}
+
+	defaultDomain := cmd.config.DefaultDomainForSpace(cmd.config.Space)
+
+	table := [][]string{
+		[]string{"name", "status", "owning org", "default"},
+	}
+
+	for _, domain := range domains {
+		status := "owned"
+		if domain.Shared {
+			status = "shared"
+		}
+
+		isDefault := ""
+		if domain.Name == defaultDomain {
+			isDefault = "yes"
+		}
+
+		table = append(table, []string{
+			domain.Name,
+			status,
+			domain.OwningOrganization.Name,
+			isDefault,
+		})
+	}
+
+	cmd.ui.DisplayTable(table, nil)
+}
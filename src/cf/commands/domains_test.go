@@ -0,0 +1,77 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestDomainsRequirements(t *testing.T) {
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	config := &configuration.Configuration{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+	callDomains(reqFactory, config, domainRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: false}
+	callDomains(reqFactory, config, domainRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: false, OrgSuccess: true}
+	callDomains(reqFactory, config, domainRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestListDomains(t *testing.T) {
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	space := cf.Space{Name: "my-space", Guid: "my-space-guid"}
+	config := &configuration.Configuration{Organization: org, Space: space}
+	config.SetDefaultDomainForSpace(space, "example.com")
+
+	domainRepo := &testhelpers.FakeDomainRepository{
+		FindAllInCurrentOrgDomains: []cf.Domain{
+			cf.Domain{Name: "cf-app.com", Shared: true},
+			cf.Domain{Name: "example.com", OwningOrganization: org},
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callDomains(reqFactory, config, domainRepo)
+
+	assert.Equal(t, len(ui.Outputs), 5)
+	assert.Contains(t, ui.Outputs[0], "Getting domains in org")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Contains(t, ui.Outputs[1], "OK")
+
+	assert.Contains(t, ui.Outputs[3], "cf-app.com")
+	assert.Contains(t, ui.Outputs[3], "shared")
+	assert.NotContains(t, ui.Outputs[3], "yes")
+
+	assert.Contains(t, ui.Outputs[4], "example.com")
+	assert.Contains(t, ui.Outputs[4], "owned")
+	assert.Contains(t, ui.Outputs[4], "my-org")
+	assert.Contains(t, ui.Outputs[4], "yes")
+}
+
+func TestListDomainsWhenThereAreNone(t *testing.T) {
+	config := &configuration.Configuration{Organization: cf.Organization{Name: "my-org", Guid: "my-org-guid"}}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callDomains(reqFactory, config, domainRepo)
+
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[2], "No domains found")
+}
+
+func callDomains(reqFactory *testhelpers.FakeReqFactory, config *configuration.Configuration, domainRepo *testhelpers.FakeDomainRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("domains", []string{})
+	cmd := NewDomains(ui, config, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type EnableServiceAccess struct {
+	ui             term.UI
+	serviceRepo    api.ServiceRepository
+	visibilityRepo api.ServicePlanVisibilityRepository
+	orgRepo        api.OrganizationRepository
+}
+
+func NewEnableServiceAccess(ui term.UI, serviceRepo api.ServiceRepository, visibilityRepo api.ServicePlanVisibilityRepository, orgRepo api.OrganizationRepository) (cmd *EnableServiceAccess) {
+	cmd = new(EnableServiceAccess)
+	cmd.ui = ui
+	cmd.serviceRepo = serviceRepo
+	cmd.visibilityRepo = visibilityRepo
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd *EnableServiceAccess) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "enable-service-access")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *EnableServiceAccess) Run(c *cli.Context) {
+	label := c.Args()[0]
+	planName := c.String("p")
+	orgName := c.String("o")
+
+	plans, err := findServiceAccessPlans(cmd.serviceRepo, label, planName)
+	if err != nil {
+		cmd.ui.Failed("Error finding service plans", err)
+		return
+	}
+
+	if orgName == "" {
+		cmd.ui.Say("Enabling access to %s for all orgs...", serviceAccessDescription(label, planName))
+
+		for _, plan := range plans {
+			if plan.Public {
+				continue
+			}
+
+			err = cmd.serviceRepo.SetServicePlanPublic(plan, true)
+			if err != nil {
+				cmd.ui.Failed("Error enabling service access", err)
+				return
+			}
+		}
+
+		cmd.ui.Ok()
+		return
+	}
+
+	org, err := cmd.orgRepo.FindByName(orgName)
+	if err != nil {
+		cmd.ui.Failed("Error finding organization", err)
+		return
+	}
+
+	visibilities, err := cmd.visibilityRepo.FindAll()
+	if err != nil {
+		cmd.ui.Failed("Error loading service plan visibilities", err)
+		return
+	}
+
+	cmd.ui.Say("Enabling access to %s for org %s...", serviceAccessDescription(label, planName), term.Cyan(org.Name))
+
+	for _, plan := range plans {
+		if plan.Public || planIsVisibleToOrg(visibilities, plan.Guid, org.Guid) {
+			continue
+		}
+
+		err = cmd.visibilityRepo.Create(plan, org)
+		if err != nil {
+			cmd.ui.Failed("Error enabling service access", err)
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+}
+
+func planIsVisibleToOrg(visibilities []cf.ServicePlanVisibility, planGuid string, orgGuid string) bool {
+	for _, visibility := range visibilities {
+		if visibility.ServicePlanGuid == planGuid && visibility.OrganizationGuid == orgGuid {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestEnableServiceAccessFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: []cf.ServiceOffering{serviceAccessOffering}}
+	visibilityRepo := &testhelpers.FakeServicePlanVisibilityRepo{}
+	orgRepo := &testhelpers.FakeOrgRepository{}
+
+	ui := callEnableServiceAccess([]string{}, reqFactory, serviceRepo, visibilityRepo, orgRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callEnableServiceAccess([]string{"my-service"}, reqFactory, serviceRepo, visibilityRepo, orgRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestEnableServiceAccessRequirements(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	callEnableServiceAccess([]string{"my-service"}, reqFactory, serviceRepo, &testhelpers.FakeServicePlanVisibilityRepo{}, &testhelpers.FakeOrgRepository{})
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestEnableServiceAccessForAllOrgs(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: []cf.ServiceOffering{serviceAccessOffering}}
+	visibilityRepo := &testhelpers.FakeServicePlanVisibilityRepo{}
+
+	ui := callEnableServiceAccess([]string{"my-service"}, reqFactory, serviceRepo, visibilityRepo, &testhelpers.FakeOrgRepository{})
+
+	assert.Contains(t, ui.Outputs[0], "Enabling access to all plans of service")
+	assert.Contains(t, ui.Outputs[0], "my-service")
+	assert.Contains(t, ui.Outputs[0], "for all orgs")
+	assert.Equal(t, len(serviceRepo.SetServicePlanPublicPlans), 2)
+	assert.Equal(t, serviceRepo.SetServicePlanPublicPlans[0].Name, "limited-plan")
+	assert.Equal(t, serviceRepo.SetServicePlanPublicPlans[1].Name, "private-plan")
+	assert.Equal(t, serviceRepo.SetServicePlanPublicValues, []bool{true, true})
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestEnableServiceAccessForOnePlan(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: []cf.ServiceOffering{serviceAccessOffering}}
+	visibilityRepo := &testhelpers.FakeServicePlanVisibilityRepo{}
+
+	ui := callEnableServiceAccess([]string{"-p", "private-plan", "my-service"}, reqFactory, serviceRepo, visibilityRepo, &testhelpers.FakeOrgRepository{})
+
+	assert.Contains(t, ui.Outputs[0], "Enabling access to plan")
+	assert.Contains(t, ui.Outputs[0], "private-plan")
+	assert.Equal(t, len(serviceRepo.SetServicePlanPublicPlans), 1)
+	assert.Equal(t, serviceRepo.SetServicePlanPublicPlans[0].Guid, "private-plan-guid")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestEnableServiceAccessForOneOrg(t *testing.T) {
+	org := cf.Organization{Name: "org-1", Guid: "org-1-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: []cf.ServiceOffering{serviceAccessOffering}}
+	visibilityRepo := &testhelpers.FakeServicePlanVisibilityRepo{
+		Visibilities: []cf.ServicePlanVisibility{
+			cf.ServicePlanVisibility{ServicePlanGuid: "limited-plan-guid", OrganizationGuid: "org-1-guid"},
+		},
+	}
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByName: org}
+
+	ui := callEnableServiceAccess([]string{"-o", "org-1", "my-service"}, reqFactory, serviceRepo, visibilityRepo, orgRepo)
+
+	assert.Equal(t, orgRepo.OrganizationName, "org-1")
+	assert.Contains(t, ui.Outputs[0], "for org")
+	assert.Contains(t, ui.Outputs[0], "org-1")
+	assert.Equal(t, len(serviceRepo.SetServicePlanPublicPlans), 0)
+	assert.Equal(t, len(visibilityRepo.CreatedPlans), 1)
+	assert.Equal(t, visibilityRepo.CreatedPlans[0].Guid, "private-plan-guid")
+	assert.Equal(t, visibilityRepo.CreatedOrgs[0], org)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestEnableServiceAccessWhenPlanIsNotFound(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: []cf.ServiceOffering{serviceAccessOffering}}
+
+	ui := callEnableServiceAccess([]string{"-p", "other-plan", "my-service"}, reqFactory, serviceRepo, &testhelpers.FakeServicePlanVisibilityRepo{}, &testhelpers.FakeOrgRepository{})
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "other-plan")
+	assert.Equal(t, len(serviceRepo.SetServicePlanPublicPlans), 0)
+}
+
+func TestEnableServiceAccessWhenServiceIsNotFound(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: []cf.ServiceOffering{serviceAccessOffering}}
+
+	ui := callEnableServiceAccess([]string{"other-service"}, reqFactory, serviceRepo, &testhelpers.FakeServicePlanVisibilityRepo{}, &testhelpers.FakeOrgRepository{})
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "Service other-service not found")
+}
+
+func callEnableServiceAccess(args []string, reqFactory *testhelpers.FakeReqFactory, serviceRepo *testhelpers.FakeServiceRepo, visibilityRepo *testhelpers.FakeServicePlanVisibilityRepo, orgRepo *testhelpers.FakeOrgRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("enable-service-access", args)
+	cmd := NewEnableServiceAccess(ui, serviceRepo, visibilityRepo, orgRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -35,6 +35,7 @@ func (f Factory) NewLogin() Login {
 		f.repoLocator.GetOrganizationRepository(),
 		f.repoLocator.GetSpaceRepository(),
 		authenticator,
+		f.NewTarget(),
 	)
 }
 
@@ -105,6 +106,7 @@ func (f Factory) NewStacks() *Stacks {
 func (f Factory) NewCreateService() CreateService {
 	return NewCreateService(
 		f.ui,
+		f.repoLocator.GetConfig(),
 		f.repoLocator.GetServiceRepository(),
 	)
 }
@@ -126,14 +128,137 @@ func (f Factory) NewUnbindService() *UnbindService {
 func (f Factory) NewDeleteService() *DeleteService {
 	return NewDeleteService(
 		f.ui,
+		f.repoLocator.GetConfig(),
 		f.repoLocator.GetServiceRepository(),
 	)
 }
 
 func (f Factory) NewRoutes() *Routes {
 	return NewRoutes(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetRouteRepository(),
+	)
+}
+
+func (f Factory) NewCreateRoute() *CreateRoute {
+	return NewCreateRoute(
+		f.ui,
+		f.repoLocator.GetRouteRepository(),
+		f.repoLocator.GetSpaceRepository(),
+		f.repoLocator.GetDomainRepository(),
+	)
+}
+
+func (f Factory) NewDeleteRoute() *DeleteRoute {
+	return NewDeleteRoute(
+		f.ui,
+		f.repoLocator.GetRouteRepository(),
+		f.repoLocator.GetDomainRepository(),
+	)
+}
+
+func (f Factory) NewMapRoute() *MapRoute {
+	return NewMapRoute(
+		f.ui,
+		f.repoLocator.GetRouteRepository(),
+		f.repoLocator.GetDomainRepository(),
+	)
+}
+
+func (f Factory) NewUnmapRoute() *UnmapRoute {
+	return NewUnmapRoute(
 		f.ui,
 		f.repoLocator.GetRouteRepository(),
+		f.repoLocator.GetDomainRepository(),
+	)
+}
+
+func (f Factory) NewOrgs() *Orgs {
+	return NewOrgs(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetOrganizationRepository(),
+	)
+}
+
+func (f Factory) NewShowOrg() *ShowOrg {
+	return NewShowOrg(
+		f.ui,
+		f.repoLocator.GetOrganizationRepository(),
+	)
+}
+
+func (f Factory) NewSpaces() *Spaces {
+	return NewSpaces(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetSpaceRepository(),
+	)
+}
+
+func (f Factory) NewShowSpace() *ShowSpace {
+	return NewShowSpace(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetOrganizationRepository(),
+		f.repoLocator.GetSpaceRepository(),
+		f.repoLocator.GetDomainRepository(),
+	)
+}
+
+func (f Factory) NewDeleteOrphanedRoutes() *DeleteOrphanedRoutes {
+	return NewDeleteOrphanedRoutes(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetRouteRepository(),
+	)
+}
+
+func (f Factory) NewDomains() *Domains {
+	return NewDomains(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetDomainRepository(),
+	)
+}
+
+func (f Factory) NewCreateDomain() *CreateDomain {
+	return NewCreateDomain(
+		f.ui,
+		f.repoLocator.GetOrganizationRepository(),
+		f.repoLocator.GetDomainRepository(),
+	)
+}
+
+func (f Factory) NewMapDomain() *MapDomain {
+	return NewMapDomain(
+		f.ui,
+		f.repoLocator.GetSpaceRepository(),
+		f.repoLocator.GetDomainRepository(),
+	)
+}
+
+func (f Factory) NewUnmapDomain() *UnmapDomain {
+	return NewUnmapDomain(
+		f.ui,
+		f.repoLocator.GetSpaceRepository(),
+		f.repoLocator.GetDomainRepository(),
+	)
+}
+
+func (f Factory) NewDeleteDomain() *DeleteDomain {
+	return NewDeleteDomain(
+		f.ui,
+		f.repoLocator.GetDomainRepository(),
+	)
+}
+
+func (f Factory) NewSetDefaultDomain() *SetDefaultDomain {
+	return NewSetDefaultDomain(
+		f.ui,
+		f.repoLocator.GetConfigurationRepository(),
+		f.repoLocator.GetDomainRepository(),
 	)
 }
 
@@ -150,3 +275,284 @@ func (f Factory) NewMarketplaceServices() MarketplaceServices {
 		f.repoLocator.GetServiceRepository(),
 	)
 }
+
+func (f Factory) NewCreateOrg() *CreateOrg {
+	return NewCreateOrg(
+		f.ui,
+		f.repoLocator.GetOrganizationRepository(),
+	)
+}
+
+func (f Factory) NewRenameOrg() *RenameOrg {
+	return NewRenameOrg(
+		f.ui,
+		f.repoLocator.GetConfigurationRepository(),
+		f.repoLocator.GetOrganizationRepository(),
+	)
+}
+
+func (f Factory) NewDeleteOrg() *DeleteOrg {
+	return NewDeleteOrg(
+		f.ui,
+		f.repoLocator.GetConfigurationRepository(),
+		f.repoLocator.GetOrganizationRepository(),
+	)
+}
+
+func (f Factory) NewCreateSpace() *CreateSpace {
+	return NewCreateSpace(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetSpaceRepository(),
+	)
+}
+
+func (f Factory) NewRenameSpace() *RenameSpace {
+	return NewRenameSpace(
+		f.ui,
+		f.repoLocator.GetConfigurationRepository(),
+		f.repoLocator.GetSpaceRepository(),
+	)
+}
+
+func (f Factory) NewDeleteSpace() *DeleteSpace {
+	return NewDeleteSpace(
+		f.ui,
+		f.repoLocator.GetConfigurationRepository(),
+		f.repoLocator.GetSpaceRepository(),
+	)
+}
+
+func (f Factory) NewOrgUsers() *OrgUsers {
+	return NewOrgUsers(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetOrganizationRepository(),
+		f.repoLocator.GetUserRepository(),
+	)
+}
+
+func (f Factory) NewSpaceUsers() *SpaceUsers {
+	return NewSpaceUsers(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetSpaceRepository(),
+		f.repoLocator.GetUserRepository(),
+	)
+}
+
+func (f Factory) NewSetOrgRole() *SetOrgRole {
+	return NewSetOrgRole(
+		f.ui,
+		f.repoLocator.GetOrganizationRepository(),
+		f.repoLocator.GetUserRepository(),
+	)
+}
+
+func (f Factory) NewUnsetOrgRole() *UnsetOrgRole {
+	return NewUnsetOrgRole(
+		f.ui,
+		f.repoLocator.GetOrganizationRepository(),
+		f.repoLocator.GetUserRepository(),
+	)
+}
+
+func (f Factory) NewSetSpaceRole() *SetSpaceRole {
+	return NewSetSpaceRole(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetSpaceRepository(),
+		f.repoLocator.GetUserRepository(),
+	)
+}
+
+func (f Factory) NewUnsetSpaceRole() *UnsetSpaceRole {
+	return NewUnsetSpaceRole(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetSpaceRepository(),
+		f.repoLocator.GetUserRepository(),
+	)
+}
+
+func (f Factory) NewListQuotas() *ListQuotas {
+	return NewListQuotas(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetQuotaRepository(),
+	)
+}
+
+func (f Factory) NewShowQuota() *ShowQuota {
+	return NewShowQuota(
+		f.ui,
+		f.repoLocator.GetQuotaRepository(),
+	)
+}
+
+func (f Factory) NewCreateQuota() *CreateQuota {
+	return NewCreateQuota(
+		f.ui,
+		f.repoLocator.GetQuotaRepository(),
+	)
+}
+
+func (f Factory) NewUpdateQuota() *UpdateQuota {
+	return NewUpdateQuota(
+		f.ui,
+		f.repoLocator.GetQuotaRepository(),
+	)
+}
+
+func (f Factory) NewSetQuota() *SetQuota {
+	return NewSetQuota(
+		f.ui,
+		f.repoLocator.GetOrganizationRepository(),
+		f.repoLocator.GetQuotaRepository(),
+	)
+}
+
+func (f Factory) NewQuotaUsage() *QuotaUsage {
+	return NewQuotaUsage(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetOrganizationRepository(),
+		f.repoLocator.GetQuotaRepository(),
+	)
+}
+
+func (f Factory) NewShowService() *ShowService {
+	return NewShowService(
+		f.ui,
+	)
+}
+
+func (f Factory) NewUpdateService() *UpdateService {
+	return NewUpdateService(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetServiceRepository(),
+	)
+}
+
+func (f Factory) NewUpdateUserProvidedService() *UpdateUserProvidedService {
+	return NewUpdateUserProvidedService(
+		f.ui,
+		f.repoLocator.GetServiceRepository(),
+	)
+}
+
+func (f Factory) NewCreateServiceKey() *CreateServiceKey {
+	return NewCreateServiceKey(
+		f.ui,
+		f.repoLocator.GetServiceRepository(),
+	)
+}
+
+func (f Factory) NewListServiceKeys() *ListServiceKeys {
+	return NewListServiceKeys(
+		f.ui,
+		f.repoLocator.GetServiceRepository(),
+	)
+}
+
+func (f Factory) NewShowServiceKey() *ShowServiceKey {
+	return NewShowServiceKey(
+		f.ui,
+		f.repoLocator.GetServiceRepository(),
+	)
+}
+
+func (f Factory) NewDeleteServiceKey() *DeleteServiceKey {
+	return NewDeleteServiceKey(
+		f.ui,
+		f.repoLocator.GetServiceRepository(),
+	)
+}
+
+func (f Factory) NewRenameApp() *RenameApp {
+	return NewRenameApp(
+		f.ui,
+		f.repoLocator.GetApplicationRepository(),
+	)
+}
+
+func (f Factory) NewRenameService() *RenameService {
+	return NewRenameService(
+		f.ui,
+		f.repoLocator.GetServiceRepository(),
+	)
+}
+
+func (f Factory) NewListServiceBrokers() *ListServiceBrokers {
+	return NewListServiceBrokers(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetServiceBrokerRepository(),
+	)
+}
+
+func (f Factory) NewCreateServiceBroker() *CreateServiceBroker {
+	return NewCreateServiceBroker(
+		f.ui,
+		f.repoLocator.GetServiceBrokerRepository(),
+	)
+}
+
+func (f Factory) NewUpdateServiceBroker() *UpdateServiceBroker {
+	return NewUpdateServiceBroker(
+		f.ui,
+		f.repoLocator.GetServiceBrokerRepository(),
+	)
+}
+
+func (f Factory) NewRenameServiceBroker() *RenameServiceBroker {
+	return NewRenameServiceBroker(
+		f.ui,
+		f.repoLocator.GetServiceBrokerRepository(),
+	)
+}
+
+func (f Factory) NewDeleteServiceBroker() *DeleteServiceBroker {
+	return NewDeleteServiceBroker(
+		f.ui,
+		f.repoLocator.GetServiceBrokerRepository(),
+	)
+}
+
+func (f Factory) NewServiceAccess() *ServiceAccess {
+	return NewServiceAccess(
+		f.ui,
+		f.repoLocator.GetConfig(),
+		f.repoLocator.GetServiceRepository(),
+		f.repoLocator.GetServicePlanVisibilityRepository(),
+		f.repoLocator.GetOrganizationRepository(),
+	)
+}
+
+func (f Factory) NewEnableServiceAccess() *EnableServiceAccess {
+	return NewEnableServiceAccess(
+		f.ui,
+		f.repoLocator.GetServiceRepository(),
+		f.repoLocator.GetServicePlanVisibilityRepository(),
+		f.repoLocator.GetOrganizationRepository(),
+	)
+}
+
+func (f Factory) NewDisableServiceAccess() *DisableServiceAccess {
+	return NewDisableServiceAccess(
+		f.ui,
+		f.repoLocator.GetServiceRepository(),
+		f.repoLocator.GetServicePlanVisibilityRepository(),
+		f.repoLocator.GetOrganizationRepository(),
+	)
+}
+
+func (f Factory) NewRebindServices() *RebindServices {
+	return NewRebindServices(
+		f.ui,
+		f.repoLocator.GetServiceRepository(),
+		f.repoLocator.GetApplicationRepository(),
+		f.NewStart(),
+	)
+}
@@ -6,28 +6,32 @@ import (
 	"cf/configuration"
 	"cf/requirements"
 	term "cf/terminal"
+	"errors"
 	"github.com/codegangsta/cli"
+	"os"
 	"strconv"
 )
 
 const maxLoginTries = 3
 
 type Login struct {
-	ui            term.UI
-	config        *configuration.Configuration
-	configRepo    configuration.ConfigurationRepository
-	orgRepo       api.OrganizationRepository
-	spaceRepo     api.SpaceRepository
-	authenticator api.Authenticator
+	ui             term.UI
+	config         *configuration.Configuration
+	configRepo     configuration.ConfigurationRepository
+	orgRepo        api.OrganizationRepository
+	spaceRepo      api.SpaceRepository
+	authenticator  api.Authenticator
+	endpointSetter ApiEndpointSetter
 }
 
-func NewLogin(ui term.UI, configRepo configuration.ConfigurationRepository, orgRepo api.OrganizationRepository, spaceRepo api.SpaceRepository, authenticator api.Authenticator) (l Login) {
+func NewLogin(ui term.UI, configRepo configuration.ConfigurationRepository, orgRepo api.OrganizationRepository, spaceRepo api.SpaceRepository, authenticator api.Authenticator, endpointSetter ApiEndpointSetter) (l Login) {
 	l.ui = ui
 	l.configRepo = configRepo
 	l.config, _ = configRepo.Get()
 	l.orgRepo = orgRepo
 	l.spaceRepo = spaceRepo
 	l.authenticator = authenticator
+	l.endpointSetter = endpointSetter
 	return
 }
 
@@ -35,78 +39,137 @@ func (cmd Login) GetRequirements(reqFactory requirements.Factory, c *cli.Context
 	return
 }
 
+// A password given with -p or CF_PASSWORD makes the login non-interactive:
+// nothing is prompted for, and an org or space that cannot be decided on
+// without asking is an error.
 func (l Login) Run(c *cli.Context) {
+	if c.String("a") != "" {
+		err := l.endpointSetter.SetApiEndpoint(c.String("a"))
+		if err != nil {
+			return
+		}
+	}
+
 	l.ui.Say("target: %s", term.Cyan(l.config.Target))
 
-	var email string
-	if len(c.Args()) > 0 {
-		email = c.Args()[0]
-	} else {
+	email := loginUsername(c)
+	password := loginPassword(c)
+	interactive := password == ""
+
+	if email == "" {
+		if !interactive {
+			l.failed(interactive, "Error Authenticating", errors.New("No username given, use -u or CF_USERNAME"))
+			return
+		}
 		email = l.ui.Ask("Username%s", term.Cyan(">"))
 	}
 
 	for i := 0; i < maxLoginTries; i++ {
-		password := l.ui.AskForPassword("Password%s", term.Cyan(">"))
+		if interactive {
+			password = l.ui.AskForPassword("Password%s", term.Cyan(">"))
+		}
 		l.ui.Say("Authenticating...")
 
 		err := l.authenticator.Authenticate(email, password)
 
 		if err != nil {
-			l.ui.Failed("Error Authenticating", err)
+			l.failed(interactive, "Error Authenticating", err)
+			if !interactive {
+				return
+			}
 			continue
 		}
 
 		l.ui.Ok()
 
-		organizations, err := l.orgRepo.FindAll()
-
-		if err != nil {
-			l.ui.Failed("Error fetching organizations.", err)
-			return
-		}
-
-		if len(organizations) == 0 {
-			l.ui.Say("No orgs found. Use 'cf create-organization' as an Administrator.")
+		targeted, err := l.targetOrganization(l.config, c.String("o"), interactive)
+		if err != nil || !targeted {
 			return
 		}
 
-		l.targetOrganization(l.config, organizations)
-
-		spaces, err := l.spaceRepo.FindAll()
-
+		err = l.targetSpace(l.config, c.String("s"), interactive)
 		if err != nil {
-			l.ui.Failed("Error fetching spaces.", err)
 			return
 		}
 
-		if len(spaces) == 0 {
-			l.ui.ShowConfiguration(l.config)
+		l.ui.ShowConfiguration(l.config)
+
+		if !l.config.HasSpace() {
 			l.ui.Say("No spaces found. Use 'cf create-space' as an Org Manager.")
-			return
 		}
 
-		l.targetSpace(l.config, spaces)
-		l.ui.ShowConfiguration(l.config)
+		return
+	}
+}
 
+// Without a prompt there is nobody to retry, so a non-interactive login
+// failure also exits non-zero for the scripts running it.
+func (l Login) failed(interactive bool, message string, err error) {
+	if interactive {
+		l.ui.Failed(message, err)
 		return
 	}
+	l.ui.FailAndExit(message, err)
+}
+
+func loginUsername(c *cli.Context) string {
+	if c.String("u") != "" {
+		return c.String("u")
+	}
+	if len(c.Args()) > 0 {
+		return c.Args()[0]
+	}
+	return os.Getenv("CF_USERNAME")
 }
 
-func (l Login) targetOrganization(config *configuration.Configuration, organizations []cf.Organization) {
+func loginPassword(c *cli.Context) string {
+	if c.String("p") != "" {
+		return c.String("p")
+	}
+	return os.Getenv("CF_PASSWORD")
+}
+
+func (l Login) targetOrganization(config *configuration.Configuration, orgName string, interactive bool) (targeted bool, err error) {
 	var selectedOrg cf.Organization
 
-	if len(organizations) == 1 {
-		selectedOrg = organizations[0]
+	if orgName != "" {
+		selectedOrg, err = l.orgRepo.FindByName(orgName)
+		if err != nil {
+			l.failed(interactive, "Error finding organization", err)
+			return
+		}
 	} else {
-		selectedOrg = l.chooseOrg(organizations)
+		var organizations []cf.Organization
+		organizations, err = l.orgRepo.FindAll()
+
+		if err != nil {
+			l.failed(interactive, "Error fetching organizations.", err)
+			return
+		}
+
+		switch {
+		case len(organizations) == 0:
+			l.ui.Say("No orgs found. Use 'cf create-org' as an Administrator.")
+			return
+		case len(organizations) == 1:
+			selectedOrg = organizations[0]
+		case !interactive:
+			err = errors.New("More than one org found, use -o to choose one")
+			l.failed(interactive, "Error targeting organization", err)
+			return
+		default:
+			selectedOrg = l.chooseOrg(organizations)
+		}
 	}
 
 	l.ui.Say("Targeting org %s...", term.Cyan(selectedOrg.Name))
-	err := l.saveOrg(config, selectedOrg)
+	err = l.saveOrg(config, selectedOrg, interactive)
 
 	if err == nil {
 		l.ui.Ok()
+		targeted = true
 	}
+	return
 }
 
 func (l Login) chooseOrg(orgs []cf.Organization) (org cf.Organization) {
@@ -124,30 +187,61 @@ func (l Login) chooseOrg(orgs []cf.Organization) (org cf.Organization) {
 	return orgs[index-1]
 }
 
-func (l Login) saveOrg(config *configuration.Configuration, org cf.Organization) (err error) {
+func (l Login) saveOrg(config *configuration.Configuration, org cf.Organization, interactive bool) (err error) {
 	config.Organization = org
+	config.Space = cf.Space{}
 	err = l.configRepo.Save()
 
 	if err != nil {
-		l.ui.Failed("Error saving organization: %s", err)
+		l.failed(interactive, "Error saving organization: %s", err)
 		return
 	}
 
 	return
 }
 
-func (l Login) targetSpace(config *configuration.Configuration, spaces []cf.Space) {
-	if len(spaces) == 1 {
-		l.saveSpace(config, spaces[0])
-	} else {
+func (l Login) targetSpace(config *configuration.Configuration, spaceName string, interactive bool) (err error) {
+	if spaceName != "" {
+		var space cf.Space
+		space, err = l.spaceRepo.FindByName(spaceName)
+		if err != nil {
+			l.failed(interactive, "Error finding space", err)
+			return
+		}
+
+		l.ui.Say("Targeting space %s...", term.Cyan(space.Name))
+		err = l.saveSpace(config, space, interactive)
+		if err == nil {
+			l.ui.Ok()
+		}
+		return
+	}
+
+	spaces, err := l.spaceRepo.FindAll()
+
+	if err != nil {
+		l.failed(interactive, "Error fetching spaces.", err)
+		return
+	}
+
+	switch {
+	case len(spaces) == 0:
+		return
+	case len(spaces) == 1:
+		err = l.saveSpace(config, spaces[0], interactive)
+	case !interactive:
+		err = errors.New("More than one space found, use -s to choose one")
+		l.failed(interactive, "Error targeting space", err)
+	default:
 		selectedSpace := l.chooseSpace(spaces)
 		l.ui.Say("Targeting space %s...", term.Cyan(selectedSpace.Name))
-		err := l.saveSpace(config, selectedSpace)
+		err = l.saveSpace(config, selectedSpace, interactive)
 
 		if err == nil {
 			l.ui.Ok()
 		}
 	}
+	return
 }
 
 func (l Login) chooseSpace(spaces []cf.Space) (space cf.Space) {
@@ -165,12 +259,12 @@ func (l Login) chooseSpace(spaces []cf.Space) (space cf.Space) {
 	return spaces[index-1]
 }
 
-func (l Login) saveSpace(config *configuration.Configuration, space cf.Space) (err error) {
+func (l Login) saveSpace(config *configuration.Configuration, space cf.Space, interactive bool) (err error) {
 	config.Space = space
 	err = l.configRepo.Save()
 
 	if err != nil {
-		l.ui.Failed("Error saving organization: %s", err)
+		l.failed(interactive, "Error saving organization: %s", err)
 		return
 	}
 
@@ -7,6 +7,8 @@ import (
 	"cf/configuration"
 	term "cf/terminal"
 	"github.com/stretchr/testify/assert"
+	"os"
+	"strings"
 	"testhelpers"
 	"testing"
 )
@@ -87,8 +89,8 @@ func TestLoggingInWithMultipleOrgsAndSpaces(t *testing.T) {
 	ui.Inputs = []string{"foo@example.com", "bar", "2", "1"}
 
 	orgs := []cf.Organization{
-		cf.Organization{"FirstOrg", "org-1-guid"},
-		cf.Organization{"SecondOrg", "org-2-guid"},
+		cf.Organization{Name: "FirstOrg", Guid: "org-1-guid"},
+		cf.Organization{Name: "SecondOrg", Guid: "org-2-guid"},
 	}
 	spaces := []cf.Space{
 		cf.Space{Name: "FirstSpace", Guid: "space-1-guid"},
@@ -131,8 +133,8 @@ func TestWhenUserPicksInvalidOrgNumberAndSpaceNumber(t *testing.T) {
 	configRepo.Delete()
 
 	orgs := []cf.Organization{
-		cf.Organization{"Org1", "org-1-guid"},
-		cf.Organization{"Org2", "org-2-guid"},
+		cf.Organization{Name: "Org1", Guid: "org-1-guid"},
+		cf.Organization{Name: "Org2", Guid: "org-2-guid"},
 	}
 
 	spaces := []cf.Space{
@@ -178,7 +180,7 @@ func TestLoggingInWitOneOrgAndOneSpace(t *testing.T) {
 	ui.Inputs = []string{"foo@example.com", "bar"}
 
 	orgs := []cf.Organization{
-		cf.Organization{"FirstOrg", "org-1-guid"},
+		cf.Organization{Name: "FirstOrg", Guid: "org-1-guid"},
 	}
 	spaces := []cf.Space{
 		cf.Space{Name: "FirstSpace", Guid: "space-1-guid"},
@@ -249,7 +251,7 @@ func TestLoggingInWithOneOrgAndNoSpace(t *testing.T) {
 	ui := new(testhelpers.FakeUI)
 	ui.Inputs = []string{"foo@example.com", "bar"}
 	orgs := []cf.Organization{
-		cf.Organization{"FirstOrg", "org-1-guid"},
+		cf.Organization{Name: "FirstOrg", Guid: "org-1-guid"},
 	}
 	spaces := []cf.Space{}
 
@@ -307,9 +309,227 @@ func TestUnsuccessfullyLoggingIn(t *testing.T) {
 	assert.Equal(t, ui.Outputs[6], "FAILED")
 	assert.Equal(t, ui.Outputs[9], "Authenticating...")
 	assert.Equal(t, ui.Outputs[10], "FAILED")
+	assert.False(t, ui.FailedAndExited)
+}
+
+func TestLoggingInWithFlags(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testhelpers.FakeUI)
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	space := cf.Space{Name: "my-space", Guid: "my-space-guid"}
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByName: org}
+	spaceRepo := &testhelpers.FakeSpaceRepository{SpaceByName: space}
+	auth := &testhelpers.FakeAuthenticator{ConfigRepo: configRepo}
+
+	callLogin(
+		[]string{"-u", "foo@example.com", "-p", "bar", "-o", "my-org", "-s", "my-space"},
+		ui,
+		configRepo,
+		orgRepo,
+		spaceRepo,
+		auth,
+	)
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Equal(t, auth.Email, "foo@example.com")
+	assert.Equal(t, auth.Password, "bar")
+	assert.Equal(t, orgRepo.OrganizationName, "my-org")
+	assert.Equal(t, spaceRepo.SpaceName, "my-space")
+
+	savedConfig := testhelpers.SavedConfiguration
+	assert.Equal(t, savedConfig.Organization, org)
+	assert.Equal(t, savedConfig.Space, space)
+}
+
+func TestLoggingInWithEnvironmentVariables(t *testing.T) {
+	os.Setenv("CF_USERNAME", "foo@example.com")
+	os.Setenv("CF_PASSWORD", "bar")
+	defer os.Setenv("CF_USERNAME", "")
+	defer os.Setenv("CF_PASSWORD", "")
+
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testhelpers.FakeUI)
+	orgs := []cf.Organization{cf.Organization{Name: "FirstOrg", Guid: "org-1-guid"}}
+	spaces := []cf.Space{cf.Space{Name: "FirstSpace", Guid: "space-1-guid"}}
+	auth := &testhelpers.FakeAuthenticator{ConfigRepo: configRepo}
+
+	callLogin(
+		[]string{},
+		ui,
+		configRepo,
+		&testhelpers.FakeOrgRepository{Organizations: orgs},
+		&testhelpers.FakeSpaceRepository{Spaces: spaces},
+		auth,
+	)
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Equal(t, auth.Email, "foo@example.com")
+	assert.Equal(t, auth.Password, "bar")
+
+	savedConfig := testhelpers.SavedConfiguration
+	assert.Equal(t, savedConfig.Organization, orgs[0])
+	assert.Equal(t, savedConfig.Space, spaces[0])
+}
+
+func TestNonInteractiveLoginWithMultipleOrgs(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testhelpers.FakeUI)
+	orgs := []cf.Organization{
+		cf.Organization{Name: "FirstOrg", Guid: "org-1-guid"},
+		cf.Organization{Name: "SecondOrg", Guid: "org-2-guid"},
+	}
+
+	callLogin(
+		[]string{"-u", "foo@example.com", "-p", "bar"},
+		ui,
+		configRepo,
+		&testhelpers.FakeOrgRepository{Organizations: orgs},
+		&testhelpers.FakeSpaceRepository{},
+		&testhelpers.FakeAuthenticator{ConfigRepo: configRepo},
+	)
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Contains(t, ui.Outputs[3], "FAILED")
+	assert.Contains(t, ui.Outputs[5], "More than one org found, use -o to choose one")
+	assert.True(t, ui.FailedAndExited)
+	assert.Equal(t, testhelpers.SavedConfiguration.Organization, cf.Organization{})
+}
+
+func TestNonInteractiveLoginWithMultipleSpaces(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testhelpers.FakeUI)
+	orgs := []cf.Organization{cf.Organization{Name: "FirstOrg", Guid: "org-1-guid"}}
+	spaces := []cf.Space{
+		cf.Space{Name: "FirstSpace", Guid: "space-1-guid"},
+		cf.Space{Name: "SecondSpace", Guid: "space-2-guid"},
+	}
+
+	callLogin(
+		[]string{"-u", "foo@example.com", "-p", "bar"},
+		ui,
+		configRepo,
+		&testhelpers.FakeOrgRepository{Organizations: orgs},
+		&testhelpers.FakeSpaceRepository{Spaces: spaces},
+		&testhelpers.FakeAuthenticator{ConfigRepo: configRepo},
+	)
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Contains(t, strings.Join(ui.Outputs, "\n"), "More than one space found, use -s to choose one")
+	assert.True(t, ui.FailedAndExited)
+
+	savedConfig := testhelpers.SavedConfiguration
+	assert.Equal(t, savedConfig.Organization, orgs[0])
+	assert.Equal(t, savedConfig.Space, cf.Space{})
+}
+
+func TestNonInteractiveLoginIsNotRetried(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testhelpers.FakeUI)
+
+	callLogin(
+		[]string{"-u", "foo@example.com", "-p", "bar"},
+		ui,
+		configRepo,
+		&testhelpers.FakeOrgRepository{},
+		&testhelpers.FakeSpaceRepository{},
+		&testhelpers.FakeAuthenticator{AuthError: true, ConfigRepo: configRepo},
+	)
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Equal(t, ui.Outputs[1], "Authenticating...")
+	assert.Equal(t, ui.Outputs[2], "FAILED")
+	assert.Equal(t, len(ui.Outputs), 5)
+	assert.True(t, ui.FailedAndExited)
+}
+
+func TestNonInteractiveLoginWithoutUsername(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testhelpers.FakeUI)
+	auth := &testhelpers.FakeAuthenticator{ConfigRepo: configRepo}
+
+	callLogin(
+		[]string{"-p", "bar"},
+		ui,
+		configRepo,
+		&testhelpers.FakeOrgRepository{},
+		&testhelpers.FakeSpaceRepository{},
+		auth,
+	)
+
+	assert.Equal(t, len(ui.Prompts), 0)
+	assert.Contains(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, strings.Join(ui.Outputs, "\n"), "use -u or CF_USERNAME")
+	assert.True(t, ui.FailedAndExited)
+	assert.Equal(t, auth.Email, "")
+}
+
+func TestNonInteractiveLoginWithUnknownOrg(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testhelpers.FakeUI)
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByNameErr: true}
+
+	callLogin(
+		[]string{"-u", "foo@example.com", "-p", "bar", "-o", "my-org"},
+		ui,
+		configRepo,
+		orgRepo,
+		&testhelpers.FakeSpaceRepository{},
+		&testhelpers.FakeAuthenticator{ConfigRepo: configRepo},
+	)
+
+	assert.Equal(t, orgRepo.OrganizationName, "my-org")
+	assert.Contains(t, ui.Outputs[3], "FAILED")
+	assert.Contains(t, ui.Outputs[4], "Error finding organization")
+	assert.True(t, ui.FailedAndExited)
+	assert.Equal(t, testhelpers.SavedConfiguration.Organization, cf.Organization{})
+}
+
+func TestLoggingInWithApiEndpoint(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testhelpers.FakeUI)
+	setter := &testhelpers.FakeApiEndpointSetter{}
+	auth := &testhelpers.FakeAuthenticator{ConfigRepo: configRepo}
+	orgs := []cf.Organization{cf.Organization{Name: "FirstOrg", Guid: "org-1-guid"}}
+
+	l := NewLogin(ui, configRepo, &testhelpers.FakeOrgRepository{Organizations: orgs}, &testhelpers.FakeSpaceRepository{}, auth, setter)
+	l.Run(testhelpers.NewContext("login", []string{"-a", "https://api.example.com", "-u", "foo@example.com", "-p", "bar"}))
+
+	assert.Equal(t, setter.Endpoint, "https://api.example.com")
+	assert.Equal(t, auth.Email, "foo@example.com")
+}
+
+func TestLoggingInWhenApiEndpointCannotBeSet(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	ui := new(testhelpers.FakeUI)
+	setter := &testhelpers.FakeApiEndpointSetter{Err: true}
+	auth := &testhelpers.FakeAuthenticator{ConfigRepo: configRepo}
+
+	l := NewLogin(ui, configRepo, &testhelpers.FakeOrgRepository{}, &testhelpers.FakeSpaceRepository{}, auth, setter)
+	l.Run(testhelpers.NewContext("login", []string{"-a", "https://api.example.com", "-u", "foo@example.com", "-p", "bar"}))
+
+	assert.Equal(t, len(ui.Outputs), 0)
+	assert.Equal(t, auth.Email, "")
 }
 
 func callLogin(args []string, ui term.UI, configRepo configuration.ConfigurationRepository, orgRepo api.OrganizationRepository, spaceRepo api.SpaceRepository, auth api.Authenticator) {
-	l := NewLogin(ui, configRepo, orgRepo, spaceRepo, auth)
+	l := NewLogin(ui, configRepo, orgRepo, spaceRepo, auth, &testhelpers.FakeApiEndpointSetter{})
 	l.Run(testhelpers.NewContext("login", args))
 }
@@ -0,0 +1,73 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestMapDomainRequirements(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+	callMapDomain([]string{"my-space", "example.com"}, reqFactory, spaceRepo, domainRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: false}
+	callMapDomain([]string{"my-space", "example.com"}, reqFactory, spaceRepo, domainRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestMapDomainFailsWithUsage(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callMapDomain([]string{"my-space"}, reqFactory, spaceRepo, domainRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callMapDomain([]string{"my-space", "example.com"}, reqFactory, spaceRepo, domainRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestMapDomain(t *testing.T) {
+	space := cf.Space{Name: "my-space", Guid: "my-space-guid"}
+	domain := cf.Domain{Name: "example.com", Guid: "example-domain-guid"}
+	spaceRepo := &testhelpers.FakeSpaceRepository{SpaceByName: space}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameInCurrentOrgDomain: domain}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callMapDomain([]string{"my-space", "example.com"}, reqFactory, spaceRepo, domainRepo)
+
+	assert.Equal(t, spaceRepo.SpaceName, "my-space")
+	assert.Equal(t, domainRepo.FindByNameInCurrentOrgName, "example.com")
+	assert.Contains(t, ui.Outputs[0], "Mapping domain")
+	assert.Contains(t, ui.Outputs[0], "example.com")
+	assert.Contains(t, ui.Outputs[0], "my-space")
+	assert.Equal(t, domainRepo.MapDomainDomain, domain)
+	assert.Equal(t, domainRepo.MapDomainSpace, space)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestMapDomainWhenDomainIsNotFound(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{SpaceByName: cf.Space{Name: "my-space", Guid: "my-space-guid"}}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameInCurrentOrgErr: true}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callMapDomain([]string{"my-space", "example.com"}, reqFactory, spaceRepo, domainRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Error finding domain")
+	assert.Equal(t, domainRepo.MapDomainDomain.Guid, "")
+}
+
+func callMapDomain(args []string, reqFactory *testhelpers.FakeReqFactory, spaceRepo *testhelpers.FakeSpaceRepository, domainRepo *testhelpers.FakeDomainRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("map-domain", args)
+	cmd := NewMapDomain(ui, spaceRepo, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
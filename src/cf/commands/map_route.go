@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type MapRoute struct {
+	ui         term.UI
+	routeRepo  api.RouteRepository
+	domainRepo api.DomainRepository
+	appReq     requirements.ApplicationRequirement
+}
+
+func NewMapRoute(ui term.UI, routeRepo api.RouteRepository, domainRepo api.DomainRepository) (cmd *MapRoute) {
+	cmd = new(MapRoute)
+	cmd.ui = ui
+	cmd.routeRepo = routeRepo
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *MapRoute) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 || c.String("n") == "" {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "map-route")
+		return
+	}
+
+	cmd.appReq = reqFactory.NewApplicationRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+		cmd.appReq,
+	}
+	return
+}
+
+func (cmd *MapRoute) Run(c *cli.Context) {
+	app := cmd.appReq.GetApplication()
+
+	domain, err := cmd.domainRepo.FindByName(c.Args()[1])
+	if err != nil {
+		cmd.ui.Failed("Error finding domain", err)
+		return
+	}
+
+	host := c.String("n")
+	route, err := cmd.routeRepo.FindByHostAndDomain(host, domain)
+	if err != nil {
+		newRoute := cf.Route{Host: host, Domain: domain}
+
+		cmd.ui.Say("Creating route %s...", term.Cyan(newRoute.URL()))
+		route, _, err = cmd.routeRepo.Create(newRoute, domain)
+		if err != nil {
+			cmd.ui.Failed("Error creating route", err)
+			return
+		}
+		route.Domain = domain
+		cmd.ui.Ok()
+	}
+
+	cmd.ui.Say("Binding %s to %s...", term.Cyan(route.URL()), term.Cyan(app.Name))
+
+	err = cmd.routeRepo.Bind(route, app)
+	if err != nil {
+		cmd.ui.Failed("Error binding route", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,75 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestMapRouteFailsWithUsage(t *testing.T) {
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callMapRoute([]string{"my-app", "example.com"}, reqFactory, routeRepo, domainRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callMapRoute([]string{"-n", "my-host", "my-app"}, reqFactory, routeRepo, domainRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callMapRoute([]string{"-n", "my-host", "my-app", "example.com"}, reqFactory, routeRepo, domainRepo)
+	assert.False(t, ui.FailedWithUsage)
+	assert.Equal(t, reqFactory.ApplicationName, "my-app")
+}
+
+func TestMapRouteWhenRouteExists(t *testing.T) {
+	domain := cf.Domain{Name: "example.com", Guid: "example-domain-guid"}
+	route := cf.Route{Host: "my-host", Guid: "my-route-guid", Domain: domain}
+	routeRepo := &testhelpers.FakeRouteRepository{FindByHostAndDomainRoute: route}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	app := cf.Application{Name: "my-app", Guid: "my-app-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, Application: app}
+
+	ui := callMapRoute([]string{"-n", "my-host", "my-app", "example.com"}, reqFactory, routeRepo, domainRepo)
+
+	assert.Equal(t, routeRepo.FindByHostAndDomainHost, "my-host")
+	assert.Equal(t, routeRepo.FindByHostAndDomainDomain, domain)
+	assert.Equal(t, routeRepo.CreatedRoute.Host, "")
+	assert.Contains(t, ui.Outputs[0], "Binding")
+	assert.Contains(t, ui.Outputs[0], "my-host.example.com")
+	assert.Contains(t, ui.Outputs[0], "my-app")
+	assert.Equal(t, routeRepo.BoundRoute, route)
+	assert.Equal(t, routeRepo.BoundApp, app)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestMapRouteWhenRouteDoesNotExist(t *testing.T) {
+	domain := cf.Domain{Name: "example.com", Guid: "example-domain-guid"}
+	routeRepo := &testhelpers.FakeRouteRepository{FindByHostAndDomainErr: true}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	app := cf.Application{Name: "my-app", Guid: "my-app-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, Application: app}
+
+	ui := callMapRoute([]string{"-n", "my-host", "my-app", "example.com"}, reqFactory, routeRepo, domainRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Creating route")
+	assert.Contains(t, ui.Outputs[0], "my-host.example.com")
+	assert.Equal(t, routeRepo.CreatedRoute.Host, "my-host")
+	assert.Equal(t, routeRepo.CreatedRouteDomain, domain)
+	assert.Contains(t, ui.Outputs[1], "OK")
+
+	assert.Contains(t, ui.Outputs[2], "Binding")
+	assert.Equal(t, routeRepo.BoundRoute.Guid, "my-host-guid")
+	assert.Equal(t, routeRepo.BoundApp, app)
+	assert.Contains(t, ui.Outputs[3], "OK")
+}
+
+func callMapRoute(args []string, reqFactory *testhelpers.FakeReqFactory, routeRepo *testhelpers.FakeRouteRepository, domainRepo *testhelpers.FakeDomainRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("map-route", args)
+	cmd := NewMapRoute(ui, routeRepo, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
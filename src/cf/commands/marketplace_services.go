@@ -1,10 +1,14 @@
 package commands
 
 import (
+	"cf"
 	"cf/api"
 	"cf/requirements"
 	term "cf/terminal"
+	"errors"
+	"fmt"
 	"github.com/codegangsta/cli"
+	"sort"
 	"strings"
 )
 
@@ -24,6 +28,11 @@ func (cmd MarketplaceServices) GetRequirements(reqFactory requirements.Factory,
 }
 
 func (cmd MarketplaceServices) Run(c *cli.Context) {
+	if c.String("s") != "" {
+		cmd.showPlans(c.String("s"))
+		return
+	}
+
 	cmd.ui.Say("Getting services from marketplace...")
 
 	serviceOfferings, err := cmd.serviceRepo.GetServiceOfferings()
@@ -40,6 +49,12 @@ func (cmd MarketplaceServices) Run(c *cli.Context) {
 	}
 
 	for _, offering := range serviceOfferings {
+		// The plans are those the user has access to, an offering without
+		// any has nothing to create from.
+		if len(offering.Plans) == 0 {
+			continue
+		}
+
 		var planNames []string
 		for _, plan := range offering.Plans {
 			planNames = append(planNames, plan.Name)
@@ -57,3 +72,72 @@ func (cmd MarketplaceServices) Run(c *cli.Context) {
 	cmd.ui.DisplayTable(table, nil)
 	return
 }
+
+func (cmd MarketplaceServices) showPlans(label string) {
+	cmd.ui.Say("Getting plans for service %s from marketplace...", term.Cyan(label))
+
+	serviceOfferings, err := cmd.serviceRepo.GetServiceOfferings()
+	if err != nil {
+		cmd.ui.Failed("Error loading service offerings", err)
+		return
+	}
+
+	offering, found := findOfferingByLabel(serviceOfferings, label)
+	if !found {
+		cmd.ui.Failed("Error finding service offering", errors.New(fmt.Sprintf("Service %s not found", label)))
+		return
+	}
+
+	plans, err := cmd.serviceRepo.GetServicePlans(offering)
+	if err != nil {
+		cmd.ui.Failed("Error loading service plans", err)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	table := [][]string{
+		[]string{"plan", "description", "free or paid", "costs"},
+	}
+
+	for _, plan := range plans {
+		freeOrPaid := "paid"
+		if plan.Free {
+			freeOrPaid = "free"
+		}
+
+		table = append(table, []string{
+			plan.Name,
+			plan.Description,
+			freeOrPaid,
+			formatPlanCosts(plan.Costs),
+		})
+	}
+
+	cmd.ui.DisplayTable(table, nil)
+}
+
+func findOfferingByLabel(offerings []cf.ServiceOffering, label string) (offering cf.ServiceOffering, found bool) {
+	for _, o := range offerings {
+		if strings.ToLower(o.Label) == strings.ToLower(label) {
+			return o, true
+		}
+	}
+	return
+}
+
+func formatPlanCosts(costs []cf.ServicePlanCost) string {
+	descriptions := []string{}
+	for _, cost := range costs {
+		currencies := []string{}
+		for currency := range cost.Amount {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+
+		for _, currency := range currencies {
+			descriptions = append(descriptions, fmt.Sprintf("%.2f %s/%s", cost.Amount[currency], strings.ToUpper(currency), strings.ToLower(cost.Unit)))
+		}
+	}
+	return strings.Join(descriptions, ", ")
+}
@@ -55,3 +55,92 @@ func TestMarketplaceServices(t *testing.T) {
 	assert.Contains(t, ui.Outputs[4], "1.4")
 	assert.Contains(t, ui.Outputs[4], "service-plan-c, service-plan-d")
 }
+
+func TestMarketplaceServicesSkipsOfferingsWithoutPlans(t *testing.T) {
+	serviceOfferings := []cf.ServiceOffering{
+		cf.ServiceOffering{Label: "private-offering", Plans: []cf.ServicePlan{}},
+		cf.ServiceOffering{
+			Label: "public-offering",
+			Plans: []cf.ServicePlan{cf.ServicePlan{Name: "public-plan"}},
+		},
+	}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: serviceOfferings}
+	ui := &testhelpers.FakeUI{}
+
+	ctxt := testhelpers.NewContext("marketplace", []string{})
+	cmd := NewMarketplaceServices(ui, serviceRepo)
+	testhelpers.RunCommand(cmd, ctxt, &testhelpers.FakeReqFactory{})
+
+	assert.Equal(t, len(ui.Outputs), 4)
+	assert.Contains(t, ui.Outputs[3], "public-offering")
+	assert.Contains(t, ui.Outputs[3], "public-plan")
+}
+
+func TestMarketplaceServicesListsPrivatePlansTheUserHasAccessTo(t *testing.T) {
+	serviceOfferings := []cf.ServiceOffering{
+		cf.ServiceOffering{
+			Label: "private-offering",
+			Plans: []cf.ServicePlan{cf.ServicePlan{Name: "org-plan", Public: false}},
+		},
+	}
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: serviceOfferings}
+	ui := &testhelpers.FakeUI{}
+
+	ctxt := testhelpers.NewContext("marketplace", []string{})
+	cmd := NewMarketplaceServices(ui, serviceRepo)
+	testhelpers.RunCommand(cmd, ctxt, &testhelpers.FakeReqFactory{})
+
+	assert.Equal(t, len(ui.Outputs), 4)
+	assert.Contains(t, ui.Outputs[3], "private-offering")
+	assert.Contains(t, ui.Outputs[3], "org-plan")
+}
+
+func TestMarketplaceServicePlans(t *testing.T) {
+	offering := cf.ServiceOffering{Label: "cleardb", Guid: "cleardb-guid"}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceOfferings: []cf.ServiceOffering{
+			cf.ServiceOffering{Label: "elephantsql", Guid: "elephantsql-guid"},
+			offering,
+		},
+		ServicePlans: []cf.ServicePlan{
+			cf.ServicePlan{Name: "spark", Description: "A small plan", Free: true},
+			cf.ServicePlan{
+				Name:        "boost",
+				Description: "A bigger plan",
+				Costs: []cf.ServicePlanCost{
+					cf.ServicePlanCost{Amount: map[string]float64{"usd": 9.5, "eur": 8}, Unit: "MONTHLY"},
+				},
+			},
+		},
+	}
+	ui := &testhelpers.FakeUI{}
+
+	ctxt := testhelpers.NewContext("marketplace", []string{"-s", "ClearDB"})
+	cmd := NewMarketplaceServices(ui, serviceRepo)
+	testhelpers.RunCommand(cmd, ctxt, &testhelpers.FakeReqFactory{})
+
+	assert.Equal(t, serviceRepo.GetServicePlansOffering, offering)
+	assert.Contains(t, ui.Outputs[0], "Getting plans for service")
+	assert.Contains(t, ui.Outputs[0], "ClearDB")
+	assert.Contains(t, ui.Outputs[1], "OK")
+
+	assert.Contains(t, ui.Outputs[3], "spark")
+	assert.Contains(t, ui.Outputs[3], "A small plan")
+	assert.Contains(t, ui.Outputs[3], "free")
+
+	assert.Contains(t, ui.Outputs[4], "boost")
+	assert.Contains(t, ui.Outputs[4], "paid")
+	assert.Contains(t, ui.Outputs[4], "8.00 EUR/monthly, 9.50 USD/monthly")
+}
+
+func TestMarketplaceServicePlansWhenOfferingIsNotFound(t *testing.T) {
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+	ui := &testhelpers.FakeUI{}
+
+	ctxt := testhelpers.NewContext("marketplace", []string{"-s", "cleardb"})
+	cmd := NewMarketplaceServices(ui, serviceRepo)
+	testhelpers.RunCommand(cmd, ctxt, &testhelpers.FakeReqFactory{})
+
+	assert.Contains(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[3], "Service cleardb not found")
+}
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"flag"
+	"github.com/codegangsta/cli"
+)
+
+const flagGivenSuffix = "-given"
+
+// OptionalStringFlag is a string flag that also tells whether it was given
+// at all, so that an empty value can be told apart from a missing flag.
+type OptionalStringFlag struct {
+	cli.StringFlag
+}
+
+func (f OptionalStringFlag) Apply(set *flag.FlagSet) {
+	value := &optionalStringValue{value: f.Value, given: new(bool)}
+	set.Var(value, f.Name, f.Usage)
+	set.BoolVar(value.given, f.Name+flagGivenSuffix, false, "")
+}
+
+func flagGiven(c *cli.Context, name string) bool {
+	return c.Bool(name + flagGivenSuffix)
+}
+
+type optionalStringValue struct {
+	value string
+	given *bool
+}
+
+func (v *optionalStringValue) Set(value string) error {
+	v.value = value
+	*v.given = true
+	return nil
+}
+
+func (v *optionalStringValue) String() string {
+	return v.value
+}
+
+func (v *optionalStringValue) Get() interface{} {
+	return v.value
+}
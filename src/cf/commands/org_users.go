@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type OrgUsers struct {
+	ui       term.UI
+	config   *configuration.Configuration
+	orgRepo  api.OrganizationRepository
+	userRepo api.UserRepository
+}
+
+func NewOrgUsers(ui term.UI, config *configuration.Configuration, orgRepo api.OrganizationRepository, userRepo api.UserRepository) (cmd *OrgUsers) {
+	cmd = new(OrgUsers)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.orgRepo = orgRepo
+	cmd.userRepo = userRepo
+	return
+}
+
+func (cmd *OrgUsers) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "org-users")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *OrgUsers) Run(c *cli.Context) {
+	org, err := cmd.orgRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding org", err)
+		return
+	}
+
+	cmd.ui.Say("Getting users in org %s as %s...", term.Cyan(org.Name), term.Cyan(cmd.config.UserEmail()))
+
+	usersByRole, err := cmd.userRepo.FindAllInOrgByRole(org)
+	if err != nil {
+		cmd.ui.Failed("Error getting users", err)
+		return
+	}
+
+	cmd.ui.Ok()
+	displayUsersByRole(cmd.ui, cf.OrgRoles, usersByRole)
+}
+
+func displayUsersByRole(ui term.UI, roles []string, usersByRole map[string][]cf.User) {
+	for _, role := range roles {
+		ui.Say("")
+		ui.Say("%s:", term.Yellow(role))
+
+		users := usersByRole[role]
+		if len(users) == 0 {
+			ui.Say("  none")
+		}
+
+		for _, user := range users {
+			ui.Say("  %s", user.Username)
+		}
+	}
+}
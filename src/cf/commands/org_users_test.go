@@ -0,0 +1,68 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestOrgUsersFailsWithUsage(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	userRepo := &testhelpers.FakeUserRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callOrgUsers([]string{}, reqFactory, orgRepo, userRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callOrgUsers([]string{"my-org"}, reqFactory, orgRepo, userRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestOrgUsersRequirements(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	userRepo := &testhelpers.FakeUserRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callOrgUsers([]string{"my-org"}, reqFactory, orgRepo, userRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestOrgUsers(t *testing.T) {
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByName: org}
+	userRepo := &testhelpers.FakeUserRepository{
+		UsersByRole: map[string][]cf.User{
+			cf.OrgManager:     []cf.User{cf.User{Username: "user1"}, cf.User{Username: "user2"}},
+			cf.BillingManager: []cf.User{cf.User{Username: "user4"}},
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callOrgUsers([]string{"my-org"}, reqFactory, orgRepo, userRepo)
+
+	assert.Equal(t, orgRepo.OrganizationName, "my-org")
+	assert.Equal(t, userRepo.FindAllInOrgByRoleOrganization, org)
+	assert.Contains(t, ui.Outputs[0], "Getting users in org")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Contains(t, ui.Outputs[0], "user1@example.com")
+	assert.Contains(t, ui.Outputs[1], "OK")
+
+	assert.Contains(t, ui.Outputs[3], "OrgManager")
+	assert.Contains(t, ui.Outputs[4], "user1")
+	assert.Contains(t, ui.Outputs[5], "user2")
+	assert.Contains(t, ui.Outputs[7], "BillingManager")
+	assert.Contains(t, ui.Outputs[8], "user4")
+	assert.Contains(t, ui.Outputs[10], "OrgAuditor")
+	assert.Contains(t, ui.Outputs[11], "none")
+}
+
+func callOrgUsers(args []string, reqFactory *testhelpers.FakeReqFactory, orgRepo *testhelpers.FakeOrgRepository, userRepo *testhelpers.FakeUserRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	config := testhelpers.FakeConfigRepository{}.Login()
+	ctxt := testhelpers.NewContext("org-users", args)
+	cmd := NewOrgUsers(ui, config, orgRepo, userRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type Orgs struct {
+	ui      term.UI
+	config  *configuration.Configuration
+	orgRepo api.OrganizationRepository
+}
+
+func NewOrgs(ui term.UI, config *configuration.Configuration, orgRepo api.OrganizationRepository) (cmd *Orgs) {
+	cmd = new(Orgs)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd *Orgs) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *Orgs) Run(c *cli.Context) {
+	cmd.ui.Say("Getting orgs as %s...", term.Cyan(cmd.config.UserEmail()))
+
+	orgs, err := cmd.orgRepo.FindAll()
+	if err != nil {
+		cmd.ui.Failed("Error getting orgs", err)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	if len(orgs) == 0 {
+		cmd.ui.Say("No orgs found")
+		return
+	}
+
+	table := [][]string{
+		[]string{"name", "targeted"},
+	}
+
+	for _, org := range orgs {
+		table = append(table, []string{
+			org.Name,
+			targetedMarker(org.Guid == cmd.config.Organization.Guid),
+		})
+	}
+
+	cmd.ui.DisplayTable(table, nil)
+}
+
+func targetedMarker(targeted bool) string {
+	if targeted {
+		return "yes"
+	}
+	return ""
+}
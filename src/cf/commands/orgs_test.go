@@ -0,0 +1,63 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestOrgsRequirements(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	config := &configuration.Configuration{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	callOrgs(reqFactory, config, orgRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callOrgs(reqFactory, config, orgRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestListingOrgs(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{
+		Organizations: []cf.Organization{
+			cf.Organization{Name: "Organization-1", Guid: "org-1-guid"},
+			cf.Organization{Name: "Organization-2", Guid: "org-2-guid"},
+		},
+	}
+	config := testhelpers.FakeConfigRepository{}.Login()
+	config.Organization = cf.Organization{Name: "Organization-2", Guid: "org-2-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callOrgs(reqFactory, config, orgRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Getting orgs as")
+	assert.Contains(t, ui.Outputs[0], "user1@example.com")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[3], "Organization-1")
+	assert.NotContains(t, ui.Outputs[3], "yes")
+	assert.Contains(t, ui.Outputs[4], "Organization-2")
+	assert.Contains(t, ui.Outputs[4], "yes")
+}
+
+func TestListingOrgsWhenThereAreNone(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callOrgs(reqFactory, &configuration.Configuration{}, orgRepo)
+
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[2], "No orgs found")
+}
+
+func callOrgs(reqFactory *testhelpers.FakeReqFactory, config *configuration.Configuration, orgRepo *testhelpers.FakeOrgRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("orgs", []string{})
+	cmd := NewOrgs(ui, config, orgRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
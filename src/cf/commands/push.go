@@ -3,14 +3,30 @@ package commands
 import (
 	"cf"
 	"cf/api"
+	"cf/formatters"
 	"cf/requirements"
 	term "cf/terminal"
+	"errors"
+	"fmt"
 	"github.com/codegangsta/cli"
+	"math/rand"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 )
 
+const (
+	routeHostTakenErrorCode = 210003
+	randomRouteAttempts     = 5
+)
+
+var randomSource = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// Hosts may only contain lowercase letters, digits and dashes.
+var hostNameSanitizer = regexp.MustCompile("[^a-z0-9-]+")
+
 type Push struct {
 	ui         term.UI
 	starter    ApplicationStarter
@@ -41,12 +57,34 @@ func (cmd Push) GetRequirements(reqFactory requirements.Factory, c *cli.Context)
 	return
 }
 
+type pushOptions struct {
+	appName     string
+	host        string
+	domain      string
+	randomRoute bool
+	noRoute     bool
+}
+
 func (p Push) Run(c *cli.Context) {
-	appName := c.String("name")
-	app, err := p.appRepo.FindByName(appName)
+	dir, err := p.appDir(c)
+	if err != nil {
+		return
+	}
 
+	opts, err := p.pushOptions(c, dir)
 	if err != nil {
-		app, err = p.createApp(appName, c)
+		return
+	}
+
+	if c.Bool("dry-run") {
+		p.dryRun(c, dir, opts)
+		return
+	}
+
+	app, err := p.appRepo.FindByName(opts.appName)
+
+	if err != nil {
+		app, err = p.createApp(c, opts)
 
 		if err != nil {
 			return
@@ -55,7 +93,26 @@ func (p Push) Run(c *cli.Context) {
 
 	p.ui.Say("Uploading %s...", app.Name)
 
-	dir := c.String("path")
+	zipBuffer, err := p.zipper.Zip(dir)
+	if err != nil {
+		p.ui.Failed("Error zipping app", err)
+		return
+	}
+
+	err = p.appRepo.Upload(app, zipBuffer, p.ui.DisplayProgress)
+	if err != nil {
+		p.ui.Failed("Error uploading app", err)
+		return
+	}
+
+	p.ui.Ok()
+	if !c.Bool("no-start") {
+		p.starter.ApplicationStart(app)
+	}
+}
+
+func (p Push) appDir(c *cli.Context) (dir string, err error) {
+	dir = c.String("path")
 	if dir == "" {
 		dir, err = os.Getwd()
 		if err != nil {
@@ -63,28 +120,98 @@ func (p Push) Run(c *cli.Context) {
 			return
 		}
 	}
+	return
+}
 
-	zipBuffer, err := p.zipper.Zip(dir)
+// The manifest in the app directory can turn on random-route or no-route.
+func (p Push) pushOptions(c *cli.Context, dir string) (opts pushOptions, err error) {
+	manifest, err := cf.LoadManifest(dir)
 	if err != nil {
-		p.ui.Failed("Error zipping app", err)
+		p.ui.Failed("Error reading manifest", err)
 		return
 	}
 
-	err = p.appRepo.Upload(app, zipBuffer)
+	manifestApp := manifest.Application(c.String("name"))
+
+	opts.appName = c.String("name")
+	opts.host = c.String("host")
+	opts.domain = c.String("domain")
+	opts.randomRoute = c.Bool("random-route") || manifestApp.Bool("random-route")
+	opts.noRoute = c.Bool("no-route") || manifestApp.Bool("no-route")
+
+	if opts.randomRoute && opts.noRoute {
+		err = errors.New("random-route and no-route cannot be used together")
+		p.ui.Failed("Error reading push options", err)
+	}
+	return
+}
+
+func (p Push) dryRun(c *cli.Context, dir string, opts pushOptions) {
+	p.ui.Say(term.Magenta("Dry run: nothing will be created, updated or uploaded."))
+
+	steps := []string{}
+
+	app, err := p.appRepo.FindByName(opts.appName)
+	if err == nil {
+		steps = append(steps, fmt.Sprintf("Update app %s", app.Name))
+	} else {
+		var plan newAppPlan
+		plan, err = p.planNewApp(c, opts)
+		if err != nil {
+			return
+		}
+		app = plan.app
+
+		steps = append(steps, fmt.Sprintf("Create app %s (%d x %dM%s)", app.Name, app.Instances, app.Memory, stackDescription(app.Stack)))
+		steps = append(steps, plan.routeSteps()...)
+	}
+
+	files, err := p.zipper.ListFiles(dir)
 	if err != nil {
-		p.ui.Failed("Error uploading app", err)
+		p.ui.Failed("Error zipping app", err)
 		return
 	}
 
-	p.ui.Ok()
+	table := [][]string{
+		[]string{"file", "size"},
+	}
+
+	var totalSize int64
+	for _, file := range files {
+		totalSize += file.Size
+		table = append(table, []string{file.Path, formatters.ByteSize(file.Size)})
+	}
+
+	p.ui.Say("Files to upload from %s:", term.Cyan(dir))
+	p.ui.DisplayTable(table, nil)
+	p.ui.Say("%d files, %s total", len(files), formatters.ByteSize(totalSize))
+
+	steps = append(steps, fmt.Sprintf("Upload %s", app.Name))
 	if !c.Bool("no-start") {
-		p.starter.ApplicationStart(app)
+		steps = append(steps, fmt.Sprintf("Start %s", app.Name))
+	}
+
+	p.ui.Say("")
+	p.ui.Say("Push would perform the following steps:")
+	for i, step := range steps {
+		p.ui.Say("%d. %s", i+1, step)
 	}
 }
 
-func (p Push) createApp(appName string, c *cli.Context) (app cf.Application, err error) {
-	newApp := cf.Application{
-		Name:         appName,
+// newAppPlan is everything push looks up before creating an app, so that
+// a dry run previews exactly what the real push does.
+type newAppPlan struct {
+	app         cf.Application
+	noRoute     bool
+	randomRoute bool
+	domain      cf.Domain
+	route       cf.Route
+	routeExists bool
+}
+
+func (p Push) planNewApp(c *cli.Context, opts pushOptions) (plan newAppPlan, err error) {
+	plan.app = cf.Application{
+		Name:         opts.appName,
 		Instances:    c.Int("instances"),
 		Memory:       getMemoryLimit(c.String("memory")),
 		BuildpackUrl: c.String("buildpack"),
@@ -92,53 +219,102 @@ func (p Push) createApp(appName string, c *cli.Context) (app cf.Application, err
 
 	stackName := c.String("stack")
 	if stackName != "" {
-		var stack cf.Stack
-		stack, err = p.stackRepo.FindByName(stackName)
-
+		plan.app.Stack, err = p.stackRepo.FindByName(stackName)
 		if err != nil {
 			p.ui.Failed("Error finding stack", err)
 			return
 		}
-		newApp.Stack = stack
-		p.ui.Say("Using stack %s.", stack.Name)
 	}
 
-	p.ui.Say("Creating %s...", appName)
-	app, err = p.appRepo.Create(newApp)
-	if err != nil {
-		p.ui.Failed("Error creating application", err)
+	plan.noRoute = opts.noRoute
+	if plan.noRoute {
 		return
 	}
-	p.ui.Ok()
-
-	domain, err := p.domainRepo.FindByName(c.String("domain"))
 
+	plan.domain, err = p.domainRepo.FindByName(opts.domain)
 	if err != nil {
 		p.ui.Failed("Error loading domain", err)
 		return
 	}
 
-	hostName := c.String("host")
+	plan.randomRoute = opts.host == "" && opts.randomRoute
+	if plan.randomRoute {
+		return
+	}
+
+	hostName := opts.host
 	if hostName == "" {
-		hostName = app.Name
+		hostName = plan.app.Name
 	}
 
-	route, err := p.routeRepo.FindByHost(hostName)
-	if err != nil {
-		newRoute := cf.Route{Host: hostName}
+	route, findErr := p.routeRepo.FindByHostAndDomain(hostName, plan.domain)
+	plan.routeExists = findErr == nil
+	if plan.routeExists {
+		plan.route = route
+	} else {
+		plan.route = cf.Route{Host: hostName, Domain: plan.domain}
+	}
+	return
+}
 
-		p.ui.Say("Creating route %s.%s...", newRoute.Host, domain.Name)
-		route, err = p.routeRepo.Create(newRoute, domain)
-		if err != nil {
-			p.ui.Failed("Error creating route", err)
-			return
+func (plan newAppPlan) routeSteps() (steps []string) {
+	switch {
+	case plan.noRoute:
+	case plan.randomRoute:
+		steps = append(steps, fmt.Sprintf("Create route with a random host on %s", plan.domain.Name))
+		steps = append(steps, fmt.Sprintf("Bind it to %s", plan.app.Name))
+	default:
+		route := cf.Route{Host: plan.route.Host, Domain: plan.domain}
+		if plan.routeExists {
+			steps = append(steps, fmt.Sprintf("Use existing route %s", route.URL()))
+		} else {
+			steps = append(steps, fmt.Sprintf("Create route %s", route.URL()))
 		}
-		p.ui.Ok()
+		steps = append(steps, fmt.Sprintf("Bind %s to %s", route.URL(), plan.app.Name))
+	}
+	return
+}
+
+func stackDescription(stack cf.Stack) string {
+	if stack.Name == "" {
+		return ""
+	}
+	return fmt.Sprintf(", stack %s", stack.Name)
+}
+
+func (p Push) createApp(c *cli.Context, opts pushOptions) (app cf.Application, err error) {
+	plan, err := p.planNewApp(c, opts)
+	if err != nil {
+		return
+	}
+
+	if plan.app.Stack.Name != "" {
+		p.ui.Say("Using stack %s.", plan.app.Stack.Name)
+	}
+
+	p.ui.Say("Creating %s...", plan.app.Name)
+	app, err = p.appRepo.Create(plan.app)
+	if err != nil {
+		p.ui.Failed("Error creating application", err)
+		return
+	}
+	p.ui.Ok()
+
+	if plan.noRoute {
+		return
+	}
+
+	var route cf.Route
+	if plan.randomRoute {
+		route, err = p.createRandomRoute(app, plan.domain)
 	} else {
-		p.ui.Say("Using route %s.%s", route.Host, domain.Name)
+		route, err = p.findOrCreateRoute(plan)
+	}
+	if err != nil {
+		return
 	}
 
-	p.ui.Say("Binding %s.%s to %s...", route.Host, domain.Name, app.Name)
+	p.ui.Say("Binding %s.%s to %s...", route.Host, plan.domain.Name, app.Name)
 	err = p.routeRepo.Bind(route, app)
 	if err != nil {
 		p.ui.Failed("Error binding route", err)
@@ -149,6 +325,70 @@ func (p Push) createApp(appName string, c *cli.Context) (app cf.Application, err
 	return
 }
 
+func (p Push) findOrCreateRoute(plan newAppPlan) (route cf.Route, err error) {
+	if plan.routeExists {
+		route = plan.route
+		p.ui.Say("Using route %s.%s", route.Host, plan.domain.Name)
+		return
+	}
+
+	newRoute := cf.Route{Host: plan.route.Host}
+
+	p.ui.Say("Creating route %s.%s...", newRoute.Host, plan.domain.Name)
+	route, _, err = p.routeRepo.Create(newRoute, plan.domain)
+	if err != nil {
+		p.ui.Failed("Error creating route", err)
+		return
+	}
+	p.ui.Ok()
+	return
+}
+
+// createRandomRoute tries a few random hosts, moving on to the next one
+// whenever the controller reports that the host is already taken.
+func (p Push) createRandomRoute(app cf.Application, domain cf.Domain) (route cf.Route, err error) {
+	for i := 0; i < randomRouteAttempts; i++ {
+		newRoute := cf.Route{Host: randomHostName(app.Name)}
+
+		p.ui.Say("Creating route %s.%s...", newRoute.Host, domain.Name)
+
+		var errorCode int
+		route, errorCode, err = p.routeRepo.Create(newRoute, domain)
+		if err == nil {
+			p.ui.Ok()
+			return
+		}
+
+		if errorCode != routeHostTakenErrorCode {
+			p.ui.Failed("Error creating route", err)
+			return
+		}
+
+		p.ui.Say("Host %s is taken, trying another one", newRoute.Host)
+	}
+
+	err = errors.New(fmt.Sprintf("No available host found after %d attempts", randomRouteAttempts))
+	p.ui.Failed("Error creating random route", err)
+	return
+}
+
+func randomHostName(appName string) string {
+	const chars = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+	suffix := make([]byte, 5)
+	for i := range suffix {
+		suffix[i] = chars[randomSource.Intn(len(chars))]
+	}
+
+	host := hostNameSanitizer.ReplaceAllString(strings.ToLower(appName), "-")
+	host = strings.Trim(host, "-")
+	if host == "" {
+		return string(suffix)
+	}
+
+	return fmt.Sprintf("%s-%s", host, suffix)
+}
+
 func getMemoryLimit(arg string) (memory int) {
 	var err error
 
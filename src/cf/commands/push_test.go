@@ -5,18 +5,30 @@ import (
 	"cf"
 	"cf/api"
 	. "cf/commands"
+	"errors"
 	"github.com/stretchr/testify/assert"
 	"os"
+	"strings"
 	"testhelpers"
 	"testing"
 )
 
 type FakeAppStarter struct {
-	StartedApp cf.Application
+	StartedApp  cf.Application
+	StartedApps []cf.Application
+
+	// StartErrCount makes the first starts fail.
+	StartErrCount int
 }
 
-func (starter *FakeAppStarter) ApplicationStart(app cf.Application) {
+func (starter *FakeAppStarter) ApplicationStart(app cf.Application) (err error) {
 	starter.StartedApp = app
+	starter.StartedApps = append(starter.StartedApps, app)
+
+	if len(starter.StartedApps) <= starter.StartErrCount {
+		err = errors.New("Error starting app.")
+	}
+	return
 }
 
 func TestPushingRequirements(t *testing.T) {
@@ -51,7 +63,7 @@ func TestPushingAppWhenItDoesNotExist(t *testing.T) {
 		cf.Domain{Name: "foo.cf-app.com", Guid: "foo-domain-guid"},
 	}
 	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domains[0]}
-	routeRepo := &testhelpers.FakeRouteRepository{FindByHostErr: true}
+	routeRepo := &testhelpers.FakeRouteRepository{FindByHostAndDomainErr: true}
 	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
 	stackRepo := &testhelpers.FakeStackRepository{}
 	fakeStarter := &FakeAppStarter{}
@@ -67,7 +79,7 @@ func TestPushingAppWhenItDoesNotExist(t *testing.T) {
 	assert.Contains(t, fakeUI.Outputs[1], "OK")
 
 	assert.Contains(t, fakeUI.Outputs[2], "Creating route my-new-app.foo.cf-app.com...")
-	assert.Equal(t, routeRepo.FindByHostHost, "my-new-app")
+	assert.Equal(t, routeRepo.FindByHostAndDomainHost, "my-new-app")
 	assert.Equal(t, routeRepo.CreatedRoute.Host, "my-new-app")
 	assert.Equal(t, routeRepo.CreatedRouteDomain.Guid, "foo-domain-guid")
 	assert.Contains(t, fakeUI.Outputs[3], "OK")
@@ -94,7 +106,7 @@ func TestPushingAppWhenItDoesNotExistButRouteExists(t *testing.T) {
 	}
 	route := cf.Route{Host: "my-new-app"}
 	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domains[0]}
-	routeRepo := &testhelpers.FakeRouteRepository{FindByHostRoute: route}
+	routeRepo := &testhelpers.FakeRouteRepository{FindByHostAndDomainRoute: route}
 	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
 	stackRepo := &testhelpers.FakeStackRepository{}
 	fakeStarter := &FakeAppStarter{}
@@ -110,7 +122,7 @@ func TestPushingAppWhenItDoesNotExistButRouteExists(t *testing.T) {
 	assert.Contains(t, fakeUI.Outputs[1], "OK")
 
 	assert.Contains(t, fakeUI.Outputs[2], "Using route my-new-app.foo.cf-app.com")
-	assert.Equal(t, routeRepo.FindByHostHost, "my-new-app")
+	assert.Equal(t, routeRepo.FindByHostAndDomainHost, "my-new-app")
 
 	assert.Contains(t, fakeUI.Outputs[3], "Binding my-new-app.foo.cf-app.com to my-new-app...")
 	assert.Equal(t, routeRepo.BoundApp.Name, "my-new-app")
@@ -128,10 +140,31 @@ func TestPushingAppWhenItDoesNotExistButRouteExists(t *testing.T) {
 	assert.Equal(t, fakeStarter.StartedApp.Name, "my-new-app")
 }
 
+func TestPushingAppWhenHostExistsOnAnotherDomain(t *testing.T) {
+	domain := cf.Domain{Name: "cfapps.io", Guid: "cfapps-domain-guid"}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	routeRepo := &testhelpers.FakeRouteRepository{FindByHostAndDomainErr: true}
+	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+	stackRepo := &testhelpers.FakeStackRepository{}
+	fakeStarter := &FakeAppStarter{}
+	zipper := &testhelpers.FakeZipper{}
+
+	fakeUI := callPush([]string{"--name", "my-new-app", "--domain", "cfapps.io"}, fakeStarter, zipper, appRepo, domainRepo, routeRepo, stackRepo)
+
+	assert.Equal(t, routeRepo.FindByHostAndDomainHost, "my-new-app")
+	assert.Equal(t, routeRepo.FindByHostAndDomainDomain, domain)
+
+	assert.Contains(t, fakeUI.Outputs[2], "Creating route my-new-app.cfapps.io...")
+	assert.Equal(t, routeRepo.CreatedRouteDomain, domain)
+
+	assert.Contains(t, fakeUI.Outputs[4], "Binding my-new-app.cfapps.io to my-new-app...")
+	assert.Equal(t, routeRepo.BoundRoute.Guid, "my-new-app-guid")
+}
+
 func TestPushingAppWithCustomFlags(t *testing.T) {
 	domain := cf.Domain{Name: "bar.cf-app.com", Guid: "bar-domain-guid"}
 	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
-	routeRepo := &testhelpers.FakeRouteRepository{FindByHostErr: true}
+	routeRepo := &testhelpers.FakeRouteRepository{FindByHostAndDomainErr: true}
 	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
 	stackRepo := &testhelpers.FakeStackRepository{FindByNameStack: cf.Stack{Name: "customLinux", Guid: "custom-linux-guid"}}
 	fakeStarter := &FakeAppStarter{}
@@ -175,6 +208,7 @@ func TestPushingAppWithCustomFlags(t *testing.T) {
 	assert.Equal(t, appRepo.UploadedApp.Guid, "my-new-app-guid")
 	assert.Equal(t, zipper.ZippedDir, "/Users/pivotal/workspace/my-new-app")
 	assert.Equal(t, appRepo.UploadedZipBuffer, zipper.ZippedBuffer)
+	assert.Equal(t, fakeUI.ProgressReports[len(fakeUI.ProgressReports)-1][0], int64(len("Zip File!")))
 	assert.Contains(t, fakeUI.Outputs[8], "OK")
 
 	assert.Equal(t, fakeStarter.StartedApp.Name, "")
@@ -247,6 +281,80 @@ func TestPushingAppWhenItAlreadyExists(t *testing.T) {
 	assert.Contains(t, fakeUI.Outputs[1], "OK")
 }
 
+func TestPushingWithDryRunDoesNotChangeAnything(t *testing.T) {
+	domain := cf.Domain{Name: "foo.cf-app.com", Guid: "foo-domain-guid"}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	routeRepo := &testhelpers.FakeRouteRepository{FindByHostAndDomainErr: true}
+	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+	stackRepo := &testhelpers.FakeStackRepository{FindByNameStack: cf.Stack{Name: "customLinux", Guid: "custom-linux-guid"}}
+	fakeStarter := &FakeAppStarter{}
+	zipper := &testhelpers.FakeZipper{ListedFiles: []cf.AppFile{
+		cf.AppFile{Path: "app.rb", Size: 1024},
+		cf.AppFile{Path: "config.ru", Size: 512},
+	}}
+
+	fakeUI := callPush([]string{
+		"--name", "my-new-app",
+		"--stack", "customLinux",
+		"--path", "/Users/pivotal/workspace/my-new-app",
+		"--dry-run",
+	}, fakeStarter, zipper, appRepo, domainRepo, routeRepo, stackRepo)
+
+	assert.Equal(t, appRepo.CreatedApp.Name, "")
+	assert.Equal(t, routeRepo.CreatedRoute.Host, "")
+	assert.Equal(t, routeRepo.BoundApp.Name, "")
+	assert.Nil(t, appRepo.UploadedZipBuffer)
+	assert.Equal(t, fakeStarter.StartedApp.Name, "")
+
+	assert.Equal(t, stackRepo.FindByNameName, "customLinux")
+	assert.Equal(t, routeRepo.FindByHostAndDomainHost, "my-new-app")
+	assert.Equal(t, zipper.ListedDir, "/Users/pivotal/workspace/my-new-app")
+	assert.Equal(t, zipper.ZippedDir, "")
+
+	output := strings.Join(fakeUI.Outputs, "\n")
+	assert.Contains(t, output, "app.rb")
+	assert.Contains(t, output, "1.0K")
+	assert.Contains(t, output, "config.ru")
+	assert.Contains(t, output, "2 files, 1.5K total")
+	assert.Contains(t, output, "1. Create app my-new-app (1 x 128M, stack customLinux)")
+	assert.Contains(t, output, "2. Create route my-new-app.foo.cf-app.com")
+	assert.Contains(t, output, "3. Bind my-new-app.foo.cf-app.com to my-new-app")
+	assert.Contains(t, output, "4. Upload my-new-app")
+	assert.Contains(t, output, "5. Start my-new-app")
+}
+
+func TestPushingLooksUpTheDomainBeforeCreatingTheApp(t *testing.T) {
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameErr: true}
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+
+	fakeUI := callPush([]string{"--name", "my-new-app", "--domain", "bar.cf-app.com"}, &FakeAppStarter{}, &testhelpers.FakeZipper{}, appRepo, domainRepo, routeRepo, &testhelpers.FakeStackRepository{})
+
+	assert.Contains(t, fakeUI.Outputs[0], "FAILED")
+	assert.Contains(t, fakeUI.Outputs[1], "Error loading domain")
+	assert.Equal(t, appRepo.CreatedApp.Name, "")
+}
+
+func TestPushingExistingAppWithDryRun(t *testing.T) {
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	existingApp := cf.Application{Name: "existing-app", Guid: "existing-app-guid"}
+	appRepo := &testhelpers.FakeApplicationRepository{AppByName: existingApp}
+	stackRepo := &testhelpers.FakeStackRepository{}
+	fakeStarter := &FakeAppStarter{}
+	zipper := &testhelpers.FakeZipper{}
+
+	fakeUI := callPush([]string{"--name", "existing-app", "--dry-run", "--no-start"}, fakeStarter, zipper, appRepo, domainRepo, routeRepo, stackRepo)
+
+	assert.Nil(t, appRepo.UploadedZipBuffer)
+
+	output := strings.Join(fakeUI.Outputs, "\n")
+	assert.Contains(t, output, "0 files, 0B total")
+	assert.Contains(t, output, "1. Update app existing-app")
+	assert.Contains(t, output, "2. Upload existing-app")
+	assert.NotContains(t, output, "Start existing-app")
+}
+
 func callPush(args []string,
 	starter ApplicationStarter,
 	zipper cf.Zipper,
@@ -263,3 +371,120 @@ func callPush(args []string,
 
 	return
 }
+
+func TestPushingAppWithRandomRoute(t *testing.T) {
+	domain := cf.Domain{Name: "foo.cf-app.com", Guid: "foo-domain-guid"}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+
+	fakeUI := callPush([]string{"--name", "my-new-app", "--random-route"}, &FakeAppStarter{}, &testhelpers.FakeZipper{}, appRepo, domainRepo, routeRepo, &testhelpers.FakeStackRepository{})
+
+	assert.Equal(t, len(routeRepo.CreateAttempts), 1)
+	assert.True(t, strings.HasPrefix(routeRepo.CreatedRoute.Host, "my-new-app-"))
+	assert.NotEqual(t, routeRepo.CreatedRoute.Host, "my-new-app-")
+	assert.Equal(t, routeRepo.FindByHostAndDomainHost, "")
+	assert.Contains(t, fakeUI.Outputs[2], "Creating route "+routeRepo.CreatedRoute.Host+".foo.cf-app.com...")
+	assert.Equal(t, routeRepo.BoundRoute.Host, routeRepo.CreatedRoute.Host)
+	assert.Equal(t, routeRepo.BoundApp.Name, "my-new-app")
+}
+
+func TestPushingAppWithRandomRouteCleansUpTheAppName(t *testing.T) {
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: cf.Domain{Name: "foo.cf-app.com", Guid: "foo-domain-guid"}}
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+
+	callPush([]string{"--name", "_My App_v2.", "--random-route"}, &FakeAppStarter{}, &testhelpers.FakeZipper{}, appRepo, domainRepo, routeRepo, &testhelpers.FakeStackRepository{})
+
+	assert.True(t, strings.HasPrefix(routeRepo.CreatedRoute.Host, "my-app-v2-"))
+	assert.Equal(t, len(routeRepo.CreatedRoute.Host), len("my-app-v2-")+5)
+}
+
+func TestPushingAppWithRandomRouteRetriesWhenHostIsTaken(t *testing.T) {
+	domain := cf.Domain{Name: "foo.cf-app.com", Guid: "foo-domain-guid"}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	routeRepo := &testhelpers.FakeRouteRepository{CreateErrorCodes: []int{210003, 210003}}
+	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+
+	fakeUI := callPush([]string{"--name", "my-new-app", "--random-route"}, &FakeAppStarter{}, &testhelpers.FakeZipper{}, appRepo, domainRepo, routeRepo, &testhelpers.FakeStackRepository{})
+
+	assert.Equal(t, len(routeRepo.CreateAttempts), 3)
+	assert.Contains(t, fakeUI.Outputs[3], "is taken")
+	assert.Equal(t, routeRepo.CreatedRoute, routeRepo.CreateAttempts[2])
+	assert.Equal(t, routeRepo.BoundRoute.Host, routeRepo.CreateAttempts[2].Host)
+}
+
+func TestPushingAppWithRandomRouteGivesUpOnOtherErrors(t *testing.T) {
+	domain := cf.Domain{Name: "foo.cf-app.com", Guid: "foo-domain-guid"}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	routeRepo := &testhelpers.FakeRouteRepository{CreateErrorCodes: []int{10001}}
+	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+
+	fakeUI := callPush([]string{"--name", "my-new-app", "--random-route"}, &FakeAppStarter{}, &testhelpers.FakeZipper{}, appRepo, domainRepo, routeRepo, &testhelpers.FakeStackRepository{})
+
+	assert.Equal(t, len(routeRepo.CreateAttempts), 1)
+	assert.Contains(t, fakeUI.Outputs[3], "FAILED")
+	assert.Contains(t, fakeUI.Outputs[4], "Error creating route")
+	assert.Equal(t, routeRepo.BoundApp.Name, "")
+	assert.Equal(t, appRepo.UploadedApp.Guid, "")
+}
+
+func TestPushingAppWithNoRoute(t *testing.T) {
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+
+	fakeUI := callPush([]string{"--name", "my-worker", "--no-route"}, &FakeAppStarter{}, &testhelpers.FakeZipper{}, appRepo, domainRepo, routeRepo, &testhelpers.FakeStackRepository{})
+
+	assert.Equal(t, appRepo.CreatedApp.Name, "my-worker")
+	assert.Equal(t, domainRepo.FindByNameName, "")
+	assert.Equal(t, len(routeRepo.CreateAttempts), 0)
+	assert.Equal(t, routeRepo.BoundApp.Name, "")
+	assert.Contains(t, fakeUI.Outputs[2], "Uploading my-worker...")
+}
+
+func TestPushingWithRandomRouteAndNoRoute(t *testing.T) {
+	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+
+	fakeUI := callPush([]string{"--name", "my-app", "--no-route", "--random-route"}, &FakeAppStarter{}, &testhelpers.FakeZipper{}, appRepo, &testhelpers.FakeDomainRepository{}, &testhelpers.FakeRouteRepository{}, &testhelpers.FakeStackRepository{})
+
+	assert.Contains(t, fakeUI.Outputs[0], "FAILED")
+	assert.Contains(t, fakeUI.Outputs[2], "cannot be used together")
+	assert.Equal(t, appRepo.CreatedApp.Name, "")
+}
+
+func TestPushingWithRouteOptionsFromManifest(t *testing.T) {
+	dir, err := os.Getwd()
+	assert.NoError(t, err)
+	manifestDir := dir + "/../../fixtures/manifest"
+
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: cf.Domain{Name: "example.com", Guid: "example-domain-guid"}}
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+
+	callPush([]string{"--name", "worker", "--path", manifestDir}, &FakeAppStarter{}, &testhelpers.FakeZipper{}, appRepo, domainRepo, routeRepo, &testhelpers.FakeStackRepository{})
+
+	assert.Equal(t, appRepo.CreatedApp.Name, "worker")
+	assert.Equal(t, len(routeRepo.CreateAttempts), 0)
+	assert.Equal(t, routeRepo.BoundApp.Name, "")
+
+	routeRepo = &testhelpers.FakeRouteRepository{}
+	appRepo = &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+
+	callPush([]string{"--name", "web", "--path", manifestDir}, &FakeAppStarter{}, &testhelpers.FakeZipper{}, appRepo, domainRepo, routeRepo, &testhelpers.FakeStackRepository{})
+
+	assert.Equal(t, domainRepo.FindByNameName, "")
+	assert.True(t, strings.HasPrefix(routeRepo.CreatedRoute.Host, "web-"))
+	assert.Equal(t, routeRepo.BoundApp.Name, "web")
+}
+
+func TestPushingWithDryRunAndRandomRoute(t *testing.T) {
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: cf.Domain{Name: "foo.cf-app.com", Guid: "foo-domain-guid"}}
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	appRepo := &testhelpers.FakeApplicationRepository{AppByNameErr: true}
+
+	fakeUI := callPush([]string{"--name", "my-new-app", "--random-route", "--dry-run"}, &FakeAppStarter{}, &testhelpers.FakeZipper{}, appRepo, domainRepo, routeRepo, &testhelpers.FakeStackRepository{})
+
+	assert.Equal(t, len(routeRepo.CreateAttempts), 0)
+	assert.Contains(t, strings.Join(fakeUI.Outputs, "\n"), "Create route with a random host on foo.cf-app.com")
+}
@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"fmt"
+	"github.com/codegangsta/cli"
+)
+
+type QuotaUsage struct {
+	ui        term.UI
+	config    *configuration.Configuration
+	orgRepo   api.OrganizationRepository
+	quotaRepo api.QuotaRepository
+}
+
+func NewQuotaUsage(ui term.UI, config *configuration.Configuration, orgRepo api.OrganizationRepository, quotaRepo api.QuotaRepository) (cmd *QuotaUsage) {
+	cmd = new(QuotaUsage)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.orgRepo = orgRepo
+	cmd.quotaRepo = quotaRepo
+	return
+}
+
+func (cmd *QuotaUsage) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *QuotaUsage) Run(c *cli.Context) {
+	cmd.ui.Say("Getting quota usage as %s...", term.Cyan(cmd.config.UserEmail()))
+
+	orgs, err := cmd.findOrgs(c)
+	if err != nil {
+		cmd.ui.Failed("Error finding orgs", err)
+		return
+	}
+
+	table := [][]string{
+		[]string{"org", "quota", "memory", "services", "routes"},
+	}
+
+	for _, org := range orgs {
+		summary, err := cmd.orgRepo.GetSummary(org)
+		if err != nil {
+			cmd.ui.Failed("Error getting org summary", err)
+			return
+		}
+
+		usage, err := cmd.quotaRepo.GetUsage(org)
+		if err != nil {
+			cmd.ui.Failed("Error getting quota usage", err)
+			return
+		}
+		usage.Services = summary.ServiceCount
+
+		quota := summary.QuotaDefinition
+		table = append(table, []string{
+			org.Name,
+			quota.Name,
+			usageDescription(formatMegabytes(usage.Memory), formatMegabytes(quota.MemoryLimit), int64(usage.Memory), int64(quota.MemoryLimit)),
+			usageDescription(fmt.Sprintf("%d", usage.Services), fmt.Sprintf("%d", quota.TotalServices), int64(usage.Services), int64(quota.TotalServices)),
+			usageDescription(fmt.Sprintf("%d", usage.Routes), fmt.Sprintf("%d", quota.TotalRoutes), int64(usage.Routes), int64(quota.TotalRoutes)),
+		})
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	cmd.ui.DisplayTable(table, nil)
+}
+
+func (cmd *QuotaUsage) findOrgs(c *cli.Context) (orgs []cf.Organization, err error) {
+	if len(c.Args()) == 0 {
+		return cmd.orgRepo.FindAll()
+	}
+
+	org, err := cmd.orgRepo.FindByName(c.Args()[0])
+	orgs = []cf.Organization{org}
+	return
+}
+
+// A negative limit means the quota does not limit the resource.
+func usageDescription(used, limit string, usedAmount, limitAmount int64) string {
+	if limitAmount < 0 {
+		return fmt.Sprintf("%s / unlimited", used)
+	}
+
+	description := fmt.Sprintf("%s / %s", used, limit)
+	if usedAmount > limitAmount {
+		return term.Red(description)
+	}
+	return description
+}
@@ -0,0 +1,101 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	term "cf/terminal"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestQuotaUsageRequirements(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callQuotaUsage([]string{}, reqFactory, orgRepo, quotaRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestQuotaUsageForAllOrgs(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{
+		Organizations: []cf.Organization{
+			cf.Organization{Name: "org-1", Guid: "org-1-guid"},
+			cf.Organization{Name: "org-2", Guid: "org-2-guid"},
+		},
+		SummaryOrganization: cf.Organization{
+			QuotaDefinition: cf.QuotaDefinition{Name: "small", MemoryLimit: 1024, TotalServices: 10, TotalRoutes: 100},
+			ServiceCount:    3,
+		},
+	}
+	quotaRepo := &testhelpers.FakeQuotaRepository{
+		UsageByOrgGuid: map[string]cf.QuotaUsage{
+			"org-1-guid": cf.QuotaUsage{Memory: 512, Routes: 20},
+			"org-2-guid": cf.QuotaUsage{Memory: 2048, Routes: 100},
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callQuotaUsage([]string{}, reqFactory, orgRepo, quotaRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Getting quota usage")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[3], "org")
+	assert.Contains(t, ui.Outputs[3], "memory")
+
+	assert.Contains(t, ui.Outputs[4], "org-1")
+	assert.Contains(t, ui.Outputs[4], "small")
+	assert.Contains(t, ui.Outputs[4], "512.0M / 1.0G")
+	assert.Contains(t, ui.Outputs[4], "3 / 10")
+	assert.Contains(t, ui.Outputs[4], "20 / 100")
+
+	assert.Contains(t, ui.Outputs[5], "org-2")
+	assert.Contains(t, ui.Outputs[5], "2.0G / 1.0G")
+	assert.Contains(t, ui.Outputs[5], "100 / 100")
+}
+
+func TestQuotaUsageWithUnlimitedQuota(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{
+		OrganizationByName: cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+		SummaryOrganization: cf.Organization{
+			QuotaDefinition: cf.QuotaDefinition{Name: "unlimited", MemoryLimit: 1024, TotalServices: -1, TotalRoutes: -1},
+			ServiceCount:    12,
+		},
+	}
+	quotaRepo := &testhelpers.FakeQuotaRepository{
+		UsageByOrgGuid: map[string]cf.QuotaUsage{
+			"my-org-guid": cf.QuotaUsage{Memory: 512, Routes: 20},
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callQuotaUsage([]string{"my-org"}, reqFactory, orgRepo, quotaRepo)
+
+	assert.Contains(t, ui.Outputs[4], "12 / unlimited")
+	assert.Contains(t, ui.Outputs[4], "20 / unlimited")
+	assert.NotContains(t, ui.Outputs[4], term.Red("12 / unlimited"))
+}
+
+func TestQuotaUsageForOneOrg(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{
+		OrganizationByName: cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+	}
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callQuotaUsage([]string{"my-org"}, reqFactory, orgRepo, quotaRepo)
+
+	assert.Equal(t, orgRepo.OrganizationName, "my-org")
+	assert.Equal(t, len(ui.Outputs), 5)
+	assert.Contains(t, ui.Outputs[4], "my-org")
+}
+
+func callQuotaUsage(args []string, reqFactory *testhelpers.FakeReqFactory, orgRepo *testhelpers.FakeOrgRepository, quotaRepo *testhelpers.FakeQuotaRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("quota-usage", args)
+	cmd := NewQuotaUsage(ui, &configuration.Configuration{}, orgRepo, quotaRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
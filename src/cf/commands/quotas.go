@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/formatters"
+	"cf/requirements"
+	term "cf/terminal"
+	"fmt"
+	"github.com/codegangsta/cli"
+)
+
+type ListQuotas struct {
+	ui        term.UI
+	config    *configuration.Configuration
+	quotaRepo api.QuotaRepository
+}
+
+func NewListQuotas(ui term.UI, config *configuration.Configuration, quotaRepo api.QuotaRepository) (cmd *ListQuotas) {
+	cmd = new(ListQuotas)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.quotaRepo = quotaRepo
+	return
+}
+
+func (cmd *ListQuotas) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ListQuotas) Run(c *cli.Context) {
+	cmd.ui.Say("Getting quotas as %s...", term.Cyan(cmd.config.UserEmail()))
+
+	quotas, err := cmd.quotaRepo.FindAll()
+	if err != nil {
+		cmd.ui.Failed("Error getting quotas", err)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	if len(quotas) == 0 {
+		cmd.ui.Say("No quotas found")
+		return
+	}
+
+	table := [][]string{
+		[]string{"name", "memory limit", "services", "routes", "paid service plans"},
+	}
+
+	for _, quota := range quotas {
+		table = append(table, []string{
+			quota.Name,
+			formatMegabytes(quota.MemoryLimit),
+			fmt.Sprintf("%d", quota.TotalServices),
+			fmt.Sprintf("%d", quota.TotalRoutes),
+			paidServicePlansDescription(quota),
+		})
+	}
+
+	cmd.ui.DisplayTable(table, nil)
+}
+
+func formatMegabytes(megabytes uint64) string {
+	return formatters.ByteSize(int64(megabytes) * formatters.MEGABYTE)
+}
+
+func paidServicePlansDescription(quota cf.QuotaDefinition) string {
+	if quota.NonBasicServicesAllowed {
+		return "allowed"
+	}
+	return "disallowed"
+}
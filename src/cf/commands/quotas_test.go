@@ -0,0 +1,62 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestListQuotasRequirements(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	callListQuotas(reqFactory, quotaRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callListQuotas(reqFactory, quotaRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestListQuotas(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{
+		FindAllQuotas: []cf.QuotaDefinition{
+			cf.QuotaDefinition{Name: "quota-1", MemoryLimit: 1024, TotalServices: 10, TotalRoutes: 100},
+			cf.QuotaDefinition{Name: "quota-2", MemoryLimit: 512, TotalServices: 5, TotalRoutes: 50, NonBasicServicesAllowed: true},
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callListQuotas(reqFactory, quotaRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Getting quotas as")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[3], "quota-1")
+	assert.Contains(t, ui.Outputs[3], "1.0G")
+	assert.Contains(t, ui.Outputs[3], "100")
+	assert.Contains(t, ui.Outputs[3], "disallowed")
+	assert.Contains(t, ui.Outputs[4], "quota-2")
+	assert.Contains(t, ui.Outputs[4], "512.0M")
+	assert.Contains(t, ui.Outputs[4], "allowed")
+}
+
+func TestListQuotasWhenThereAreNone(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callListQuotas(reqFactory, quotaRepo)
+
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[2], "No quotas found")
+}
+
+func callListQuotas(reqFactory *testhelpers.FakeReqFactory, quotaRepo *testhelpers.FakeQuotaRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("quotas", []string{})
+	cmd := NewListQuotas(ui, &configuration.Configuration{}, quotaRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,187 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+const appAlreadyBoundErrorCode = 90003
+
+type RebindServices struct {
+	ui          term.UI
+	serviceRepo api.ServiceRepository
+	appRepo     api.ApplicationRepository
+	starter     ApplicationStarter
+}
+
+func NewRebindServices(ui term.UI, serviceRepo api.ServiceRepository, appRepo api.ApplicationRepository, starter ApplicationStarter) (cmd *RebindServices) {
+	cmd = new(RebindServices)
+	cmd.ui = ui
+	cmd.serviceRepo = serviceRepo
+	cmd.appRepo = appRepo
+	cmd.starter = starter
+	return
+}
+
+func (cmd *RebindServices) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 || c.String("from") == "" || c.String("to") == "" {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "rebind-services")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+	}
+	return
+}
+
+func (cmd *RebindServices) Run(c *cli.Context) {
+	from, err := cmd.serviceRepo.FindInstanceByName(c.String("from"))
+	if err != nil {
+		cmd.ui.Failed("Error finding service instance", err)
+		return
+	}
+
+	to, err := cmd.serviceRepo.FindInstanceByName(c.String("to"))
+	if err != nil {
+		cmd.ui.Failed("Error finding service instance", err)
+		return
+	}
+
+	if from.Guid == to.Guid {
+		err = errors.New(fmt.Sprintf("Service %s cannot be rebound to itself", from.Name))
+		cmd.ui.Failed("Error rebinding services", err)
+		return
+	}
+
+	cmd.ui.Say("Rebinding apps of service %s to %s...", term.Cyan(from.Name), term.Cyan(to.Name))
+
+	apps := []cf.Application{}
+	for _, binding := range from.ServiceBindings {
+		apps = append(apps, cf.Application{Name: binding.AppName, Guid: binding.AppGuid, State: binding.AppState})
+	}
+
+	if len(apps) == 0 {
+		cmd.ui.Ok()
+		cmd.ui.Say("No apps are bound to service %s", from.Name)
+		return
+	}
+
+	boundApps := []cf.Application{}
+	for _, app := range apps {
+		cmd.ui.Say("Binding %s to %s...", term.Cyan(app.Name), term.Cyan(to.Name))
+
+		errorCode, err := cmd.serviceRepo.BindService(to, app, nil)
+		if err != nil && errorCode != appAlreadyBoundErrorCode {
+			cmd.failAndRollBack(err, from, to, boundApps, []cf.Application{}, []cf.Application{}, []cf.Application{})
+			return
+		}
+
+		// Apps that were bound to both instances before keep that binding.
+		if err == nil {
+			boundApps = append(boundApps, app)
+		}
+	}
+
+	unboundApps := []cf.Application{}
+	for _, app := range apps {
+		cmd.ui.Say("Unbinding %s from %s...", term.Cyan(app.Name), term.Cyan(from.Name))
+
+		err = cmd.serviceRepo.UnbindService(from, app)
+		if err != nil {
+			cmd.failAndRollBack(err, from, to, boundApps, unboundApps, []cf.Application{}, []cf.Application{})
+			return
+		}
+
+		unboundApps = append(unboundApps, app)
+	}
+
+	restartedApps := []cf.Application{}
+	for _, app := range apps {
+		if app.State != "started" {
+			continue
+		}
+
+		cmd.ui.Say("Stopping %s...", term.Cyan(app.Name))
+
+		err = cmd.appRepo.Stop(app)
+		if err != nil {
+			cmd.failAndRollBack(err, from, to, boundApps, unboundApps, restartedApps, []cf.Application{})
+			return
+		}
+
+		app.State = "stopped"
+		err = cmd.starter.ApplicationStart(app)
+		if err != nil {
+			cmd.failAndRollBack(err, from, to, boundApps, unboundApps, restartedApps, []cf.Application{app})
+			return
+		}
+
+		restartedApps = append(restartedApps, app)
+	}
+
+	cmd.ui.Ok()
+}
+
+// failAndRollBack puts the apps' bindings back the way they were before the
+// command ran, then starts the apps that were left stopped. Apps that were
+// restarted already are only pointed out, it is up to the user to restart
+// them once more.
+func (cmd *RebindServices) failAndRollBack(err error, from cf.ServiceInstance, to cf.ServiceInstance, boundApps []cf.Application, unboundApps []cf.Application, restartedApps []cf.Application, stoppedApps []cf.Application) {
+	cmd.ui.Say("Rolling back the binding changes...")
+
+	rollbackErrors := []string{}
+
+	for _, app := range unboundApps {
+		_, rebindErr := cmd.serviceRepo.BindService(from, app, nil)
+		if rebindErr != nil {
+			rollbackErrors = append(rollbackErrors, fmt.Sprintf("could not bind %s to %s again", app.Name, from.Name))
+		}
+	}
+
+	if len(boundApps) > 0 {
+		// The new bindings are only known to the instance once created.
+		current, findErr := cmd.serviceRepo.FindInstanceByName(to.Name)
+		if findErr != nil {
+			current = to
+		}
+
+		for _, app := range boundApps {
+			unbindErr := cmd.serviceRepo.UnbindService(current, app)
+			if unbindErr != nil {
+				rollbackErrors = append(rollbackErrors, fmt.Sprintf("could not unbind %s from %s", app.Name, to.Name))
+			}
+		}
+	}
+
+	for _, app := range stoppedApps {
+		cmd.ui.Say("Starting %s with its previous bindings...", term.Cyan(app.Name))
+
+		startErr := cmd.starter.ApplicationStart(app)
+		if startErr != nil {
+			rollbackErrors = append(rollbackErrors, fmt.Sprintf("%s is stopped", app.Name))
+		}
+	}
+
+	if len(restartedApps) > 0 {
+		names := []string{}
+		for _, app := range restartedApps {
+			names = append(names, app.Name)
+		}
+		cmd.ui.Say("TIP: Restart %s to pick up the previous bindings", strings.Join(names, ", "))
+	}
+
+	if len(rollbackErrors) > 0 {
+		err = errors.New(fmt.Sprintf("%s; rolling back failed as well, %s", err.Error(), strings.Join(rollbackErrors, ", ")))
+	}
+
+	cmd.ui.Failed("Error rebinding services", err)
+}
@@ -0,0 +1,198 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testhelpers"
+	"testing"
+)
+
+var rebindOldInstance = cf.ServiceInstance{
+	Name: "old-db",
+	Guid: "old-db-guid",
+	ServiceBindings: []cf.ServiceBinding{
+		cf.ServiceBinding{AppGuid: "app-1-guid", AppName: "app-1", AppState: "started"},
+		cf.ServiceBinding{AppGuid: "app-2-guid", AppName: "app-2", AppState: "stopped"},
+	},
+}
+
+var rebindNewInstance = cf.ServiceInstance{Name: "new-db", Guid: "new-db-guid"}
+
+var rebindApp1 = cf.Application{Name: "app-1", Guid: "app-1-guid", State: "started"}
+var rebindStoppedApp1 = cf.Application{Name: "app-1", Guid: "app-1-guid", State: "stopped"}
+var rebindApp2 = cf.Application{Name: "app-2", Guid: "app-2-guid", State: "stopped"}
+
+func TestRebindServicesFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callRebindServices([]string{"--from", "old-db"}, reqFactory, newRebindServiceRepo(), &testhelpers.FakeApplicationRepository{}, &FakeAppStarter{})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callRebindServices([]string{"--to", "new-db"}, reqFactory, newRebindServiceRepo(), &testhelpers.FakeApplicationRepository{}, &FakeAppStarter{})
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callRebindServices([]string{"--from", "old-db", "--to", "new-db"}, reqFactory, newRebindServiceRepo(), &testhelpers.FakeApplicationRepository{}, &FakeAppStarter{})
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestRebindServicesRequirements(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: false}
+	callRebindServices([]string{"--from", "old-db", "--to", "new-db"}, reqFactory, newRebindServiceRepo(), &testhelpers.FakeApplicationRepository{}, &FakeAppStarter{})
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestRebindServices(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := newRebindServiceRepo()
+	appRepo := &testhelpers.FakeApplicationRepository{}
+	starter := &FakeAppStarter{}
+
+	ui := callRebindServices([]string{"--from", "old-db", "--to", "new-db"}, reqFactory, serviceRepo, appRepo, starter)
+
+	assert.Contains(t, ui.Outputs[0], "Rebinding apps of service")
+	assert.Contains(t, ui.Outputs[0], "old-db")
+	assert.Contains(t, ui.Outputs[0], "new-db")
+
+	assert.Equal(t, serviceRepo.BindServiceApplications, []cf.Application{rebindApp1, rebindApp2})
+	assert.Equal(t, serviceRepo.BindServiceServiceInstances, []cf.ServiceInstance{rebindNewInstance, rebindNewInstance})
+	assert.Equal(t, serviceRepo.UnbindServiceApplications, []cf.Application{rebindApp1, rebindApp2})
+	assert.Equal(t, serviceRepo.UnbindServiceServiceInstances, []cf.ServiceInstance{rebindOldInstance, rebindOldInstance})
+
+	assert.Equal(t, appRepo.StoppedApps, []cf.Application{rebindApp1})
+	assert.Equal(t, starter.StartedApps, []cf.Application{rebindStoppedApp1})
+
+	assert.Contains(t, ui.Outputs[len(ui.Outputs)-1], "OK")
+}
+
+func TestRebindServicesWhenNoAppsAreBound(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		FindInstanceByNameMap: map[string]cf.ServiceInstance{
+			"old-db": cf.ServiceInstance{Name: "old-db", Guid: "old-db-guid"},
+			"new-db": rebindNewInstance,
+		},
+	}
+
+	ui := callRebindServices([]string{"--from", "old-db", "--to", "new-db"}, reqFactory, serviceRepo, &testhelpers.FakeApplicationRepository{}, &FakeAppStarter{})
+
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[2], "No apps are bound to service old-db")
+	assert.Equal(t, len(serviceRepo.BindServiceApplications), 0)
+}
+
+func TestRebindServicesToTheSameInstance(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := newRebindServiceRepo()
+
+	ui := callRebindServices([]string{"--from", "old-db", "--to", "old-db"}, reqFactory, serviceRepo, &testhelpers.FakeApplicationRepository{}, &FakeAppStarter{})
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "cannot be rebound to itself")
+	assert.Equal(t, len(serviceRepo.BindServiceApplications), 0)
+}
+
+func TestRebindServicesWhenInstanceIsNotFound(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := newRebindServiceRepo()
+
+	ui := callRebindServices([]string{"--from", "old-db", "--to", "other-db"}, reqFactory, serviceRepo, &testhelpers.FakeApplicationRepository{}, &FakeAppStarter{})
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Equal(t, len(serviceRepo.BindServiceApplications), 0)
+}
+
+func TestRebindServicesRollsBackWhenBindingFails(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := newRebindServiceRepo()
+	serviceRepo.BindServiceFailingAppGuid = "app-2-guid"
+	appRepo := &testhelpers.FakeApplicationRepository{}
+	starter := &FakeAppStarter{}
+
+	ui := callRebindServices([]string{"--from", "old-db", "--to", "new-db"}, reqFactory, serviceRepo, appRepo, starter)
+
+	assert.Contains(t, strings.Join(ui.Outputs, "\n"), "Rolling back")
+	assert.Equal(t, serviceRepo.BindServiceApplications, []cf.Application{rebindApp1, rebindApp2})
+	assert.Equal(t, serviceRepo.UnbindServiceApplications, []cf.Application{rebindApp1})
+	assert.Equal(t, serviceRepo.UnbindServiceServiceInstances, []cf.ServiceInstance{rebindNewInstance})
+	assert.Equal(t, len(appRepo.StoppedApps), 0)
+	assert.Contains(t, strings.Join(ui.Outputs, "\n"), "FAILED")
+}
+
+func TestRebindServicesRollsBackWhenUnbindingFails(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := newRebindServiceRepo()
+	serviceRepo.UnbindServiceErr = true
+	appRepo := &testhelpers.FakeApplicationRepository{}
+	starter := &FakeAppStarter{}
+
+	ui := callRebindServices([]string{"--from", "old-db", "--to", "new-db"}, reqFactory, serviceRepo, appRepo, starter)
+
+	assert.Contains(t, strings.Join(ui.Outputs, "\n"), "Rolling back")
+	assert.Equal(t, serviceRepo.UnbindServiceServiceInstances, []cf.ServiceInstance{rebindOldInstance, rebindNewInstance, rebindNewInstance})
+	assert.Equal(t, len(appRepo.StoppedApps), 0)
+	assert.Contains(t, strings.Join(ui.Outputs, "\n"), "rolling back failed as well")
+}
+
+func TestRebindServicesRollsBackWhenRestartFails(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := newRebindServiceRepo()
+	appRepo := &testhelpers.FakeApplicationRepository{}
+	starter := &FakeAppStarter{StartErrCount: 1}
+
+	ui := callRebindServices([]string{"--from", "old-db", "--to", "new-db"}, reqFactory, serviceRepo, appRepo, starter)
+
+	assert.Contains(t, strings.Join(ui.Outputs, "\n"), "Rolling back")
+	assert.Equal(t, serviceRepo.BindServiceServiceInstances, []cf.ServiceInstance{
+		rebindNewInstance, rebindNewInstance, rebindOldInstance, rebindOldInstance,
+	})
+	assert.Equal(t, serviceRepo.UnbindServiceServiceInstances, []cf.ServiceInstance{
+		rebindOldInstance, rebindOldInstance, rebindNewInstance, rebindNewInstance,
+	})
+	assert.Equal(t, starter.StartedApps, []cf.Application{rebindStoppedApp1, rebindStoppedApp1})
+	assert.Contains(t, strings.Join(ui.Outputs, "\n"), "with its previous bindings...")
+	assert.NotContains(t, strings.Join(ui.Outputs, "\n"), "rolling back failed as well")
+	assert.Contains(t, ui.Outputs[len(ui.Outputs)-1], "Error starting app.")
+}
+
+func TestRebindServicesReportsAppsLeftStopped(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := newRebindServiceRepo()
+	appRepo := &testhelpers.FakeApplicationRepository{}
+	starter := &FakeAppStarter{StartErrCount: 2}
+
+	ui := callRebindServices([]string{"--from", "old-db", "--to", "new-db"}, reqFactory, serviceRepo, appRepo, starter)
+
+	assert.Contains(t, ui.Outputs[len(ui.Outputs)-1], "rolling back failed as well, app-1 is stopped")
+}
+
+func TestRebindServicesRollsBackWhenStopFails(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := newRebindServiceRepo()
+	appRepo := &testhelpers.FakeApplicationRepository{StopAppErr: true}
+	starter := &FakeAppStarter{}
+
+	ui := callRebindServices([]string{"--from", "old-db", "--to", "new-db"}, reqFactory, serviceRepo, appRepo, starter)
+
+	assert.Contains(t, strings.Join(ui.Outputs, "\n"), "Rolling back")
+	assert.Equal(t, len(starter.StartedApps), 0)
+	assert.Contains(t, strings.Join(ui.Outputs, "\n"), "FAILED")
+}
+
+func newRebindServiceRepo() *testhelpers.FakeServiceRepo {
+	return &testhelpers.FakeServiceRepo{
+		FindInstanceByNameMap: map[string]cf.ServiceInstance{
+			"old-db": rebindOldInstance,
+			"new-db": rebindNewInstance,
+		},
+	}
+}
+
+func callRebindServices(args []string, reqFactory *testhelpers.FakeReqFactory, serviceRepo *testhelpers.FakeServiceRepo, appRepo *testhelpers.FakeApplicationRepository, starter *FakeAppStarter) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("rebind-services", args)
+	cmd := NewRebindServices(ui, serviceRepo, appRepo, starter)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
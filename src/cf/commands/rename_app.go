@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type RenameApp struct {
+	ui      term.UI
+	appRepo api.ApplicationRepository
+	appReq  requirements.ApplicationRequirement
+}
+
+func NewRenameApp(ui term.UI, appRepo api.ApplicationRepository) (cmd *RenameApp) {
+	cmd = new(RenameApp)
+	cmd.ui = ui
+	cmd.appRepo = appRepo
+	return
+}
+
+func (cmd *RenameApp) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "rename")
+		return
+	}
+
+	cmd.appReq = reqFactory.NewApplicationRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+		cmd.appReq,
+	}
+	return
+}
+
+func (cmd *RenameApp) Run(c *cli.Context) {
+	app := cmd.appReq.GetApplication()
+	newName := c.Args()[1]
+
+	cmd.ui.Say("Renaming app %s to %s...", term.Cyan(app.Name), term.Cyan(newName))
+
+	err := cmd.appRepo.Rename(app, newName)
+	if err != nil {
+		cmd.ui.Failed("Error renaming app", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,56 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestRenameAppFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	appRepo := &testhelpers.FakeApplicationRepository{}
+
+	ui := callRenameApp([]string{"my-app"}, reqFactory, appRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callRenameApp([]string{"my-app", "my-new-app"}, reqFactory, appRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestRenameAppRequirements(t *testing.T) {
+	appRepo := &testhelpers.FakeApplicationRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	callRenameApp([]string{"my-app", "my-new-app"}, reqFactory, appRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+	assert.Equal(t, reqFactory.ApplicationName, "my-app")
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: false}
+	callRenameApp([]string{"my-app", "my-new-app"}, reqFactory, appRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestRenameApp(t *testing.T) {
+	app := cf.Application{Name: "my-app", Guid: "my-app-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{Application: app, LoginSuccess: true, SpaceSuccess: true}
+	appRepo := &testhelpers.FakeApplicationRepository{}
+
+	ui := callRenameApp([]string{"my-app", "my-new-app"}, reqFactory, appRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Renaming app")
+	assert.Contains(t, ui.Outputs[0], "my-app")
+	assert.Contains(t, ui.Outputs[0], "my-new-app")
+	assert.Equal(t, appRepo.RenamedApp, app)
+	assert.Equal(t, appRepo.RenamedAppNewName, "my-new-app")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func callRenameApp(args []string, reqFactory *testhelpers.FakeReqFactory, appRepo *testhelpers.FakeApplicationRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("rename", args)
+	cmd := NewRenameApp(ui, appRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
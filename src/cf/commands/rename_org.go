@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type RenameOrg struct {
+	ui         term.UI
+	config     *configuration.Configuration
+	configRepo configuration.ConfigurationRepository
+	orgRepo    api.OrganizationRepository
+}
+
+func NewRenameOrg(ui term.UI, configRepo configuration.ConfigurationRepository, orgRepo api.OrganizationRepository) (cmd *RenameOrg) {
+	cmd = new(RenameOrg)
+	cmd.ui = ui
+	cmd.configRepo = configRepo
+	cmd.config, _ = configRepo.Get()
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd *RenameOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "rename-org")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *RenameOrg) Run(c *cli.Context) {
+	org, err := cmd.orgRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding org", err)
+		return
+	}
+
+	newName := c.Args()[1]
+
+	cmd.ui.Say("Renaming org %s to %s...", term.Cyan(org.Name), term.Cyan(newName))
+
+	err = cmd.orgRepo.Rename(org, newName)
+	if err != nil {
+		cmd.ui.Failed("Error renaming org", err)
+		return
+	}
+
+	if cmd.config.Organization.Guid == org.Guid {
+		cmd.config.Organization.Name = newName
+		err = cmd.configRepo.Save()
+		if err != nil {
+			cmd.ui.Failed("Error saving configuration", err)
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+}
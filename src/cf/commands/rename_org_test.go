@@ -0,0 +1,72 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestRenameOrgFailsWithUsage(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callRenameOrg([]string{"my-org"}, reqFactory, orgRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callRenameOrg([]string{"my-org", "my-new-org"}, reqFactory, orgRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestRenameOrgRequirements(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callRenameOrg([]string{"my-org", "my-new-org"}, reqFactory, orgRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestRenameOrg(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByName: org}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callRenameOrg([]string{"my-org", "my-new-org"}, reqFactory, orgRepo)
+
+	assert.Equal(t, orgRepo.OrganizationName, "my-org")
+	assert.Contains(t, ui.Outputs[0], "Renaming org")
+	assert.Contains(t, ui.Outputs[0], "my-new-org")
+	assert.Equal(t, orgRepo.RenameOrganization, org)
+	assert.Equal(t, orgRepo.RenameNewName, "my-new-org")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, testhelpers.SavedConfiguration.Organization.Name, "")
+}
+
+func TestRenameOrgUpdatesTargetedOrg(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+	config, _ := configRepo.Get()
+
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	config.Organization = org
+
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByName: org}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	callRenameOrg([]string{"my-org", "my-new-org"}, reqFactory, orgRepo)
+
+	assert.Equal(t, testhelpers.SavedConfiguration.Organization.Name, "my-new-org")
+	assert.Equal(t, testhelpers.SavedConfiguration.Organization.Guid, "my-org-guid")
+}
+
+func callRenameOrg(args []string, reqFactory *testhelpers.FakeReqFactory, orgRepo *testhelpers.FakeOrgRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("rename-org", args)
+	cmd := NewRenameOrg(ui, testhelpers.FakeConfigRepository{}, orgRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type RenameService struct {
+	ui                 term.UI
+	serviceRepo        api.ServiceRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewRenameService(ui term.UI, serviceRepo api.ServiceRepository) (cmd *RenameService) {
+	cmd = new(RenameService)
+	cmd.ui = ui
+	cmd.serviceRepo = serviceRepo
+	return
+}
+
+func (cmd *RenameService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "rename-service")
+		return
+	}
+
+	cmd.serviceInstanceReq = reqFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return
+}
+
+func (cmd *RenameService) Run(c *cli.Context) {
+	instance := cmd.serviceInstanceReq.GetServiceInstance()
+	newName := c.Args()[1]
+
+	cmd.ui.Say("Renaming service %s to %s...", term.Cyan(instance.Name), term.Cyan(newName))
+
+	err := cmd.serviceRepo.RenameService(instance, newName)
+	if err != nil {
+		cmd.ui.Failed("Error renaming service", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type RenameServiceBroker struct {
+	ui         term.UI
+	brokerRepo api.ServiceBrokerRepository
+}
+
+func NewRenameServiceBroker(ui term.UI, brokerRepo api.ServiceBrokerRepository) (cmd *RenameServiceBroker) {
+	cmd = new(RenameServiceBroker)
+	cmd.ui = ui
+	cmd.brokerRepo = brokerRepo
+	return
+}
+
+func (cmd *RenameServiceBroker) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "rename-service-broker")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *RenameServiceBroker) Run(c *cli.Context) {
+	broker, err := cmd.brokerRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding service broker", err)
+		return
+	}
+
+	newName := c.Args()[1]
+
+	cmd.ui.Say("Renaming service broker %s to %s...", term.Cyan(broker.Name), term.Cyan(newName))
+
+	err = cmd.brokerRepo.Rename(broker, newName)
+	if err != nil {
+		cmd.ui.Failed("Error renaming service broker", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
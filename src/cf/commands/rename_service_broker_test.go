@@ -0,0 +1,52 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestRenameServiceBrokerFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{}
+
+	ui := callRenameServiceBroker([]string{"my-broker"}, reqFactory, brokerRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callRenameServiceBroker([]string{"my-broker", "new-name"}, reqFactory, brokerRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestRenameServiceBrokerRequirements(t *testing.T) {
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callRenameServiceBroker([]string{"my-broker", "new-name"}, reqFactory, brokerRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestRenameServiceBroker(t *testing.T) {
+	broker := cf.ServiceBroker{Name: "my-broker", Guid: "my-broker-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{FindByNameServiceBroker: broker}
+
+	ui := callRenameServiceBroker([]string{"my-broker", "new-name"}, reqFactory, brokerRepo)
+
+	assert.Equal(t, brokerRepo.FindByNameName, "my-broker")
+	assert.Contains(t, ui.Outputs[0], "Renaming service broker")
+	assert.Contains(t, ui.Outputs[0], "my-broker")
+	assert.Contains(t, ui.Outputs[0], "new-name")
+	assert.Equal(t, brokerRepo.RenamedServiceBroker, broker)
+	assert.Equal(t, brokerRepo.RenamedServiceBrokerNewName, "new-name")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func callRenameServiceBroker(args []string, reqFactory *testhelpers.FakeReqFactory, brokerRepo *testhelpers.FakeServiceBrokerRepo) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("rename-service-broker", args)
+	cmd := NewRenameServiceBroker(ui, brokerRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
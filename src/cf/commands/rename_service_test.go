@@ -0,0 +1,56 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestRenameServiceFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callRenameService([]string{"my-service"}, reqFactory, serviceRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callRenameService([]string{"my-service", "new-name"}, reqFactory, serviceRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestRenameServiceRequirements(t *testing.T) {
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	callRenameService([]string{"my-service", "new-name"}, reqFactory, serviceRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+	assert.Equal(t, reqFactory.ServiceInstanceName, "my-service")
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: false, SpaceSuccess: true}
+	callRenameService([]string{"my-service", "new-name"}, reqFactory, serviceRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestRenameService(t *testing.T) {
+	instance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{ServiceInstance: instance, LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callRenameService([]string{"my-service", "new-name"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Renaming service")
+	assert.Contains(t, ui.Outputs[0], "my-service")
+	assert.Contains(t, ui.Outputs[0], "new-name")
+	assert.Equal(t, serviceRepo.RenameServiceServiceInstance, instance)
+	assert.Equal(t, serviceRepo.RenameServiceNewName, "new-name")
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func callRenameService(args []string, reqFactory *testhelpers.FakeReqFactory, serviceRepo *testhelpers.FakeServiceRepo) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("rename-service", args)
+	cmd := NewRenameService(ui, serviceRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
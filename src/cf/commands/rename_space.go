@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type RenameSpace struct {
+	ui         term.UI
+	config     *configuration.Configuration
+	configRepo configuration.ConfigurationRepository
+	spaceRepo  api.SpaceRepository
+}
+
+func NewRenameSpace(ui term.UI, configRepo configuration.ConfigurationRepository, spaceRepo api.SpaceRepository) (cmd *RenameSpace) {
+	cmd = new(RenameSpace)
+	cmd.ui = ui
+	cmd.configRepo = configRepo
+	cmd.config, _ = configRepo.Get()
+	cmd.spaceRepo = spaceRepo
+	return
+}
+
+func (cmd *RenameSpace) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "rename-space")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewOrganizationRequirement(),
+	}
+	return
+}
+
+func (cmd *RenameSpace) Run(c *cli.Context) {
+	space, err := cmd.spaceRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding space", err)
+		return
+	}
+
+	newName := c.Args()[1]
+
+	cmd.ui.Say("Renaming space %s to %s...", term.Cyan(space.Name), term.Cyan(newName))
+
+	err = cmd.spaceRepo.Rename(space, newName)
+	if err != nil {
+		cmd.ui.Failed("Error renaming space", err)
+		return
+	}
+
+	if cmd.config.Space.Guid == space.Guid {
+		cmd.config.Space.Name = newName
+		err = cmd.configRepo.Save()
+		if err != nil {
+			cmd.ui.Failed("Error saving configuration", err)
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+}
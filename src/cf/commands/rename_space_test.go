@@ -0,0 +1,72 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestRenameSpaceFailsWithUsage(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callRenameSpace([]string{"my-space"}, reqFactory, spaceRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callRenameSpace([]string{"my-space", "my-new-space"}, reqFactory, spaceRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestRenameSpaceRequirements(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: false}
+	callRenameSpace([]string{"my-space", "my-new-space"}, reqFactory, spaceRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestRenameSpace(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	space := cf.Space{Name: "my-space", Guid: "my-space-guid"}
+	spaceRepo := &testhelpers.FakeSpaceRepository{SpaceByName: space}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callRenameSpace([]string{"my-space", "my-new-space"}, reqFactory, spaceRepo)
+
+	assert.Equal(t, spaceRepo.SpaceName, "my-space")
+	assert.Contains(t, ui.Outputs[0], "Renaming space")
+	assert.Contains(t, ui.Outputs[0], "my-new-space")
+	assert.Equal(t, spaceRepo.RenameSpace.Guid, "my-space-guid")
+	assert.Equal(t, spaceRepo.RenameNewName, "my-new-space")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, testhelpers.SavedConfiguration.Space.Name, "")
+}
+
+func TestRenameSpaceUpdatesTargetedSpace(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+	config, _ := configRepo.Get()
+
+	space := cf.Space{Name: "my-space", Guid: "my-space-guid"}
+	config.Space = space
+
+	spaceRepo := &testhelpers.FakeSpaceRepository{SpaceByName: space}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	callRenameSpace([]string{"my-space", "my-new-space"}, reqFactory, spaceRepo)
+
+	assert.Equal(t, testhelpers.SavedConfiguration.Space.Name, "my-new-space")
+	assert.Equal(t, testhelpers.SavedConfiguration.Space.Guid, "my-space-guid")
+}
+
+func callRenameSpace(args []string, reqFactory *testhelpers.FakeReqFactory, spaceRepo *testhelpers.FakeSpaceRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("rename-space", args)
+	cmd := NewRenameSpace(ui, testhelpers.FakeConfigRepository{}, spaceRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
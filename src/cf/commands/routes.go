@@ -1,45 +1,81 @@
 package commands
 
 import (
+	"cf"
 	"cf/api"
+	"cf/configuration"
 	"cf/requirements"
 	term "cf/terminal"
 	"github.com/codegangsta/cli"
+	"strings"
 )
 
 type Routes struct {
 	ui        term.UI
+	config    *configuration.Configuration
 	routeRepo api.RouteRepository
 }
 
-func NewRoutes(ui term.UI, routeRepo api.RouteRepository) (cmd *Routes) {
+func NewRoutes(ui term.UI, config *configuration.Configuration, routeRepo api.RouteRepository) (cmd *Routes) {
 	cmd = new(Routes)
 	cmd.ui = ui
+	cmd.config = config
 	cmd.routeRepo = routeRepo
 	return
 }
 
-func (r Routes) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+func (cmd *Routes) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+	}
 	return
 }
 
-func (r Routes) Run(c *cli.Context) {
-	r.ui.Say("Getting routes")
+func (cmd *Routes) Run(c *cli.Context) {
+	var (
+		routes []cf.Route
+		err    error
+	)
 
-	routes, err := r.routeRepo.FindAll()
+	all := c.Bool("all")
+	if all {
+		cmd.ui.Say("Getting routes in org %s...", term.Cyan(cmd.config.Organization.Name))
+		routes, err = cmd.routeRepo.FindAllInCurrentOrg()
+	} else {
+		cmd.ui.Say("Getting routes in space %s...", term.Cyan(cmd.config.Space.Name))
+		routes, err = cmd.routeRepo.FindAllInCurrentSpace()
+	}
 
 	if err != nil {
-		r.ui.Failed("Error getting routes.", err)
+		cmd.ui.Failed("Error getting routes", err)
+		return
 	}
 
-	r.ui.Ok()
+	cmd.ui.Ok()
 
 	if len(routes) == 0 {
-		r.ui.Say("No routes found")
+		cmd.ui.Say("No routes found")
 		return
 	}
 
+	header := []string{"host", "domain", "apps"}
+	if all {
+		header = append(header, "space")
+	}
+	table := [][]string{header}
+
 	for _, route := range routes {
-		r.ui.Say(route.URL())
+		row := []string{
+			route.Host,
+			route.Domain.Name,
+			strings.Join(route.AppNames, ", "),
+		}
+		if all {
+			row = append(row, route.Space.Name)
+		}
+		table = append(table, row)
 	}
+
+	cmd.ui.DisplayTable(table, nil)
 }
@@ -3,43 +3,83 @@ package commands_test
 import (
 	"cf"
 	. "cf/commands"
+	"cf/configuration"
 	"github.com/stretchr/testify/assert"
 	"testhelpers"
 	"testing"
 )
 
+func TestRoutesRequirements(t *testing.T) {
+	routeRepo := &testhelpers.FakeRouteRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	callRoutes([]string{}, reqFactory, routeRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: false, SpaceSuccess: true}
+	callRoutes([]string{}, reqFactory, routeRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: false}
+	callRoutes([]string{}, reqFactory, routeRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
 func TestListingRoutes(t *testing.T) {
 	routes := []cf.Route{
 		cf.Route{
-			Host:   "hostname-1",
-			Domain: cf.Domain{Name: "example.com"},
+			Host:     "hostname-1",
+			Domain:   cf.Domain{Name: "example.com"},
+			AppNames: []string{"app-1", "app-2"},
 		},
 		cf.Route{
 			Host:   "hostname-2",
 			Domain: cf.Domain{Name: "cfapps.com"},
 		},
 	}
-	routeRepo := &testhelpers.FakeRouteRepository{FindAllRoutes: routes}
-
-	ui := &testhelpers.FakeUI{}
+	routeRepo := &testhelpers.FakeRouteRepository{FindAllInCurrentSpaceRoutes: routes}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
 
-	cmd := NewRoutes(ui, routeRepo)
-	cmd.Run(testhelpers.NewContext("routes", []string{}))
+	ui := callRoutes([]string{}, reqFactory, routeRepo)
 
-	assert.Contains(t, ui.Outputs[0], "Getting routes")
+	assert.Contains(t, ui.Outputs[0], "Getting routes in space")
+	assert.Contains(t, ui.Outputs[0], "my-space")
 	assert.Contains(t, ui.Outputs[1], "OK")
-	assert.Contains(t, ui.Outputs[2], "hostname-1.example.com")
-	assert.Contains(t, ui.Outputs[3], "hostname-2.cfapps.com")
+	assert.Contains(t, ui.Outputs[2], "host")
+	assert.NotContains(t, ui.Outputs[2], "space")
+	assert.Contains(t, ui.Outputs[3], "hostname-1")
+	assert.Contains(t, ui.Outputs[3], "example.com")
+	assert.Contains(t, ui.Outputs[3], "app-1, app-2")
+	assert.Contains(t, ui.Outputs[4], "hostname-2")
+	assert.Contains(t, ui.Outputs[4], "cfapps.com")
 }
 
-func TestListingRoutesWhenNoneExist(t *testing.T) {
-	routes := []cf.Route{}
-	routeRepo := &testhelpers.FakeRouteRepository{FindAllRoutes: routes}
+func TestListingRoutesInTheWholeOrg(t *testing.T) {
+	routes := []cf.Route{
+		cf.Route{
+			Host:     "hostname-1",
+			Domain:   cf.Domain{Name: "example.com"},
+			Space:    cf.Space{Name: "other-space"},
+			AppNames: []string{"app-1"},
+		},
+	}
+	routeRepo := &testhelpers.FakeRouteRepository{FindAllInCurrentOrgRoutes: routes}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callRoutes([]string{"--all"}, reqFactory, routeRepo)
 
-	ui := &testhelpers.FakeUI{}
+	assert.Contains(t, ui.Outputs[0], "Getting routes in org")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Contains(t, ui.Outputs[2], "space")
+	assert.Contains(t, ui.Outputs[3], "hostname-1")
+	assert.Contains(t, ui.Outputs[3], "other-space")
+}
+
+func TestListingRoutesWhenNoneExist(t *testing.T) {
+	routeRepo := &testhelpers.FakeRouteRepository{FindAllInCurrentSpaceRoutes: []cf.Route{}}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
 
-	cmd := NewRoutes(ui, routeRepo)
-	cmd.Run(testhelpers.NewContext("routes", []string{}))
+	ui := callRoutes([]string{}, reqFactory, routeRepo)
 
 	assert.Contains(t, ui.Outputs[0], "Getting routes")
 	assert.Contains(t, ui.Outputs[1], "OK")
@@ -48,12 +88,23 @@ func TestListingRoutesWhenNoneExist(t *testing.T) {
 
 func TestListingRoutesWhenFindFails(t *testing.T) {
 	routeRepo := &testhelpers.FakeRouteRepository{FindAllErr: true}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
 
-	ui := &testhelpers.FakeUI{}
-
-	cmd := NewRoutes(ui, routeRepo)
-	cmd.Run(testhelpers.NewContext("routes", []string{}))
+	ui := callRoutes([]string{}, reqFactory, routeRepo)
 
 	assert.Contains(t, ui.Outputs[0], "Getting routes")
 	assert.Contains(t, ui.Outputs[1], "FAILED")
 }
+
+func callRoutes(args []string, reqFactory *testhelpers.FakeReqFactory, routeRepo *testhelpers.FakeRouteRepository) (ui *testhelpers.FakeUI) {
+	ui = &testhelpers.FakeUI{}
+
+	config := &configuration.Configuration{
+		Organization: cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+		Space:        cf.Space{Name: "my-space", Guid: "my-space-guid"},
+	}
+	ctxt := testhelpers.NewContext("routes", args)
+	cmd := NewRoutes(ui, config, routeRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type ServiceAccess struct {
+	ui             term.UI
+	config         *configuration.Configuration
+	serviceRepo    api.ServiceRepository
+	visibilityRepo api.ServicePlanVisibilityRepository
+	orgRepo        api.OrganizationRepository
+}
+
+func NewServiceAccess(ui term.UI, config *configuration.Configuration, serviceRepo api.ServiceRepository, visibilityRepo api.ServicePlanVisibilityRepository, orgRepo api.OrganizationRepository) (cmd *ServiceAccess) {
+	cmd = new(ServiceAccess)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.serviceRepo = serviceRepo
+	cmd.visibilityRepo = visibilityRepo
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd *ServiceAccess) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ServiceAccess) Run(c *cli.Context) {
+	cmd.ui.Say("Getting service access as %s...", term.Cyan(cmd.config.UserEmail()))
+
+	offerings, err := cmd.serviceRepo.GetServiceOfferings()
+	if err != nil {
+		cmd.ui.Failed("Error loading service offerings", err)
+		return
+	}
+
+	visibilities, err := cmd.visibilityRepo.FindAll()
+	if err != nil {
+		cmd.ui.Failed("Error loading service plan visibilities", err)
+		return
+	}
+
+	orgs, err := cmd.orgRepo.FindAll()
+	if err != nil {
+		cmd.ui.Failed("Error loading organizations", err)
+		return
+	}
+
+	orgNames := map[string]string{}
+	for _, org := range orgs {
+		orgNames[org.Guid] = org.Name
+	}
+
+	cmd.ui.Ok()
+
+	table := [][]string{
+		[]string{"service", "plan", "access", "orgs"},
+	}
+
+	for _, offering := range offerings {
+		for _, plan := range offering.Plans {
+			access := "all"
+			names := []string{}
+
+			if !plan.Public {
+				for _, visibility := range visibilitiesOfPlan(visibilities, plan) {
+					names = append(names, orgNames[visibility.OrganizationGuid])
+				}
+
+				access = "none"
+				if len(names) > 0 {
+					access = "limited"
+				}
+			}
+
+			table = append(table, []string{
+				offering.Label,
+				plan.Name,
+				access,
+				strings.Join(names, ", "),
+			})
+		}
+	}
+
+	cmd.ui.DisplayTable(table, nil)
+}
+
+// findServiceAccessPlans returns the plans the access commands act on: the
+// plan named with -p, or every plan of the service when there is none.
+func findServiceAccessPlans(serviceRepo api.ServiceRepository, label string, planName string) (plans []cf.ServicePlan, err error) {
+	offerings, err := serviceRepo.GetServiceOfferings()
+	if err != nil {
+		return
+	}
+
+	offering, found := findOfferingByLabel(offerings, label)
+	if !found {
+		err = errors.New(fmt.Sprintf("Service %s not found", label))
+		return
+	}
+
+	if planName == "" {
+		plans = offering.Plans
+		return
+	}
+
+	plan, err := findPlan(offering.Plans, planName)
+	if err != nil {
+		return
+	}
+
+	plans = []cf.ServicePlan{plan}
+	return
+}
+
+func visibilitiesOfPlan(visibilities []cf.ServicePlanVisibility, plan cf.ServicePlan) (planVisibilities []cf.ServicePlanVisibility) {
+	for _, visibility := range visibilities {
+		if visibility.ServicePlanGuid == plan.Guid {
+			planVisibilities = append(planVisibilities, visibility)
+		}
+	}
+	return
+}
+
+func serviceAccessDescription(label string, planName string) string {
+	if planName == "" {
+		return fmt.Sprintf("all plans of service %s", term.Cyan(label))
+	}
+	return fmt.Sprintf("plan %s of service %s", term.Cyan(planName), term.Cyan(label))
+}
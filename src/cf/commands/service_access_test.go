@@ -0,0 +1,71 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+var serviceAccessOffering = cf.ServiceOffering{
+	Label: "my-service",
+	Guid:  "my-service-guid",
+	Plans: []cf.ServicePlan{
+		cf.ServicePlan{Name: "public-plan", Guid: "public-plan-guid", Public: true},
+		cf.ServicePlan{Name: "limited-plan", Guid: "limited-plan-guid"},
+		cf.ServicePlan{Name: "private-plan", Guid: "private-plan-guid"},
+	},
+}
+
+var serviceAccessOrgs = []cf.Organization{
+	cf.Organization{Name: "org-1", Guid: "org-1-guid"},
+	cf.Organization{Name: "org-2", Guid: "org-2-guid"},
+}
+
+func TestServiceAccessRequirements(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	callServiceAccess(reqFactory, &testhelpers.FakeServiceRepo{}, &testhelpers.FakeServicePlanVisibilityRepo{})
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callServiceAccess(reqFactory, &testhelpers.FakeServiceRepo{}, &testhelpers.FakeServicePlanVisibilityRepo{})
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestServiceAccess(t *testing.T) {
+	serviceRepo := &testhelpers.FakeServiceRepo{ServiceOfferings: []cf.ServiceOffering{serviceAccessOffering}}
+	visibilityRepo := &testhelpers.FakeServicePlanVisibilityRepo{
+		Visibilities: []cf.ServicePlanVisibility{
+			cf.ServicePlanVisibility{ServicePlanGuid: "limited-plan-guid", OrganizationGuid: "org-1-guid"},
+			cf.ServicePlanVisibility{ServicePlanGuid: "limited-plan-guid", OrganizationGuid: "org-2-guid"},
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callServiceAccess(reqFactory, serviceRepo, visibilityRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Getting service access as")
+	assert.Contains(t, ui.Outputs[1], "OK")
+
+	assert.Contains(t, ui.Outputs[3], "my-service")
+	assert.Contains(t, ui.Outputs[3], "public-plan")
+	assert.Contains(t, ui.Outputs[3], "all")
+
+	assert.Contains(t, ui.Outputs[4], "limited-plan")
+	assert.Contains(t, ui.Outputs[4], "limited")
+	assert.Contains(t, ui.Outputs[4], "org-1, org-2")
+
+	assert.Contains(t, ui.Outputs[5], "private-plan")
+	assert.Contains(t, ui.Outputs[5], "none")
+}
+
+func callServiceAccess(reqFactory *testhelpers.FakeReqFactory, serviceRepo *testhelpers.FakeServiceRepo, visibilityRepo *testhelpers.FakeServicePlanVisibilityRepo) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("service-access", []string{})
+	orgRepo := &testhelpers.FakeOrgRepository{Organizations: serviceAccessOrgs}
+	cmd := NewServiceAccess(ui, &configuration.Configuration{}, serviceRepo, visibilityRepo, orgRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ListServiceBrokers struct {
+	ui         term.UI
+	config     *configuration.Configuration
+	brokerRepo api.ServiceBrokerRepository
+}
+
+func NewListServiceBrokers(ui term.UI, config *configuration.Configuration, brokerRepo api.ServiceBrokerRepository) (cmd *ListServiceBrokers) {
+	cmd = new(ListServiceBrokers)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.brokerRepo = brokerRepo
+	return
+}
+
+func (cmd *ListServiceBrokers) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ListServiceBrokers) Run(c *cli.Context) {
+	cmd.ui.Say("Getting service brokers as %s...", term.Cyan(cmd.config.UserEmail()))
+
+	brokers, err := cmd.brokerRepo.FindAll()
+	if err != nil {
+		cmd.ui.Failed("Error getting service brokers", err)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	if len(brokers) == 0 {
+		cmd.ui.Say("No service brokers found")
+		return
+	}
+
+	table := [][]string{
+		[]string{"name", "url"},
+	}
+
+	for _, broker := range brokers {
+		table = append(table, []string{
+			broker.Name,
+			broker.Url,
+		})
+	}
+
+	cmd.ui.DisplayTable(table, nil)
+}
@@ -0,0 +1,59 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestListServiceBrokersRequirements(t *testing.T) {
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	callListServiceBrokers(reqFactory, brokerRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callListServiceBrokers(reqFactory, brokerRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestListServiceBrokers(t *testing.T) {
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{
+		FindAllBrokers: []cf.ServiceBroker{
+			cf.ServiceBroker{Name: "broker-1", Url: "http://broker-1.example.com"},
+			cf.ServiceBroker{Name: "broker-2", Url: "http://broker-2.example.com"},
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callListServiceBrokers(reqFactory, brokerRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Getting service brokers as")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[3], "broker-1")
+	assert.Contains(t, ui.Outputs[3], "http://broker-1.example.com")
+	assert.Contains(t, ui.Outputs[4], "broker-2")
+	assert.Contains(t, ui.Outputs[4], "http://broker-2.example.com")
+}
+
+func TestListServiceBrokersWhenThereAreNone(t *testing.T) {
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callListServiceBrokers(reqFactory, brokerRepo)
+
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[2], "No service brokers found")
+}
+
+func callListServiceBrokers(reqFactory *testhelpers.FakeReqFactory, brokerRepo *testhelpers.FakeServiceBrokerRepo) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("service-brokers", []string{})
+	cmd := NewListServiceBrokers(ui, &configuration.Configuration{}, brokerRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
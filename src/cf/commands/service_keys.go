@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type ListServiceKeys struct {
+	ui                 term.UI
+	serviceRepo        api.ServiceRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewListServiceKeys(ui term.UI, serviceRepo api.ServiceRepository) (cmd *ListServiceKeys) {
+	cmd = new(ListServiceKeys)
+	cmd.ui = ui
+	cmd.serviceRepo = serviceRepo
+	return
+}
+
+func (cmd *ListServiceKeys) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "service-keys")
+		return
+	}
+
+	cmd.serviceInstanceReq = reqFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return
+}
+
+func (cmd *ListServiceKeys) Run(c *cli.Context) {
+	instance := cmd.serviceInstanceReq.GetServiceInstance()
+
+	cmd.ui.Say("Getting keys for service instance %s...", term.Cyan(instance.Name))
+
+	keys, err := cmd.serviceRepo.GetServiceKeys(instance)
+	if err != nil {
+		cmd.ui.Failed("Error getting service keys", err)
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if len(keys) == 0 {
+		cmd.ui.Say("No service keys for service instance %s", instance.Name)
+		return
+	}
+
+	table := [][]string{
+		[]string{"name"},
+	}
+
+	for _, key := range keys {
+		table = append(table, []string{key.Name})
+	}
+
+	cmd.ui.DisplayTable(table, nil)
+}
@@ -0,0 +1,56 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestListServiceKeysFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callListServiceKeys([]string{}, reqFactory, serviceRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callListServiceKeys([]string{"my-service"}, reqFactory, serviceRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestListServiceKeys(t *testing.T) {
+	instance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: instance}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceKeys: []cf.ServiceKey{{Name: "key-1"}, {Name: "key-2"}},
+	}
+
+	ui := callListServiceKeys([]string{"my-service"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Getting keys for service instance")
+	assert.Contains(t, ui.Outputs[0], "my-service")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[3], "name")
+	assert.Contains(t, ui.Outputs[4], "key-1")
+	assert.Contains(t, ui.Outputs[5], "key-2")
+	assert.Equal(t, serviceRepo.GetServiceKeysInstance, instance)
+}
+
+func TestListServiceKeysWhenThereAreNone(t *testing.T) {
+	instance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: instance}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callListServiceKeys([]string{"my-service"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[3], "No service keys for service instance my-service")
+}
+
+func callListServiceKeys(args []string, reqFactory *testhelpers.FakeReqFactory, serviceRepo *testhelpers.FakeServiceRepo) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("service-keys", args)
+	cmd := NewListServiceKeys(ui, serviceRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
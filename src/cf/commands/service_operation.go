@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	term "cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"time"
+)
+
+const (
+	serviceInstanceNotFoundErrorCode = 60004
+	initialServicePollInterval       = 1 * time.Second
+	maxServicePollInterval           = 16 * time.Second
+)
+
+// serviceOperationWaiter follows the last operation of a service instance
+// whose broker may create, update or delete it asynchronously.
+type serviceOperationWaiter struct {
+	ui          term.UI
+	config      *configuration.Configuration
+	serviceRepo api.ServiceRepository
+}
+
+func newServiceOperationWaiter(ui term.UI, config *configuration.Configuration, serviceRepo api.ServiceRepository) (waiter serviceOperationWaiter) {
+	waiter.ui = ui
+	waiter.config = config
+	waiter.serviceRepo = serviceRepo
+	return
+}
+
+// Waiting is the default, --no-wait returns while the broker is still busy.
+func serviceWaitOption(c *cli.Context) (wait bool, err error) {
+	if c.Bool("wait") && c.Bool("no-wait") {
+		err = errors.New("wait and no-wait cannot be used together")
+		return
+	}
+
+	wait = !c.Bool("no-wait")
+	return
+}
+
+// Follow polls the instance with a growing interval until its last operation
+// is no longer in progress, printing each change of state on the way.
+func (waiter serviceOperationWaiter) Follow(instance cf.ServiceInstance, operation string, wait bool) (err error) {
+	startTime := time.Now()
+	interval := initialServicePollInterval
+	lastDescription := ""
+
+	for {
+		current, errorCode, getErr := waiter.serviceRepo.GetServiceInstance(instance.Guid)
+		if getErr != nil {
+			if operation == "delete" && errorCode == serviceInstanceNotFoundErrorCode {
+				return
+			}
+			err = getErr
+			return
+		}
+
+		lastOperation := current.LastOperation
+		if lastOperation.Failed() {
+			err = errors.New(fmt.Sprintf("The service broker could not %s service %s: %s", operation, instance.Name, lastOperation.Description))
+			return
+		}
+
+		if !lastOperation.InProgress() {
+			return
+		}
+
+		description := lastOperationDescription(lastOperation)
+		if description != lastDescription {
+			waiter.ui.Say("Service %s: %s", term.Cyan(instance.Name), description)
+			lastDescription = description
+		} else {
+			waiter.ui.LoadingIndication()
+		}
+
+		if !wait {
+			waiter.ui.Say("Use 'cf service %s' to check on the %s.", instance.Name, operation)
+			return
+		}
+
+		if time.Since(startTime) > waiter.config.ServiceOperationTimeout*time.Second {
+			err = errors.New(fmt.Sprintf("Timed out after %d seconds, the %s is still in progress. Use 'cf service %s' to check on it.",
+				waiter.config.ServiceOperationTimeout, operation, instance.Name))
+			return
+		}
+
+		waiter.ui.Wait(interval)
+
+		interval = interval * 2
+		if interval > maxServicePollInterval {
+			interval = maxServicePollInterval
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// parseServiceParameters reads the -c option of the service commands, which
+// is either a JSON object or the path to a file holding one. The object is
+// passed on to the service broker untouched.
+func parseServiceParameters(value string) (params map[string]interface{}, err error) {
+	if value == "" {
+		return
+	}
+
+	data := []byte(value)
+
+	fileInfo, statErr := os.Stat(value)
+	if statErr == nil && !fileInfo.IsDir() {
+		data, err = ioutil.ReadFile(value)
+		if err != nil {
+			return
+		}
+	}
+
+	err = json.Unmarshal(data, &params)
+	if err != nil || params == nil {
+		params = nil
+		err = errors.New("Configuration must be a JSON object, or the path to a file containing one")
+	}
+	return
+}
+
+// parseUserProvidedCredentials reads the -p option for user-provided
+// services, a JSON object given inline or as @ followed by a file path.
+// Credential values may be nested objects.
+func parseUserProvidedCredentials(value string) (credentials map[string]interface{}, err error) {
+	data := []byte(value)
+
+	if strings.HasPrefix(value, "@") {
+		data, err = ioutil.ReadFile(strings.TrimPrefix(value, "@"))
+		if err != nil {
+			return
+		}
+	}
+
+	err = json.Unmarshal(data, &credentials)
+	if err != nil || credentials == nil {
+		credentials = nil
+		err = errors.New("Credentials must be a JSON object, or @ followed by the path to a file containing one")
+	}
+	return
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type SetDefaultDomain struct {
+	ui         term.UI
+	config     *configuration.Configuration
+	configRepo configuration.ConfigurationRepository
+	domainRepo api.DomainRepository
+}
+
+func NewSetDefaultDomain(ui term.UI, configRepo configuration.ConfigurationRepository, domainRepo api.DomainRepository) (cmd *SetDefaultDomain) {
+	cmd = new(SetDefaultDomain)
+	cmd.ui = ui
+	cmd.configRepo = configRepo
+	cmd.config, _ = configRepo.Get()
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *SetDefaultDomain) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "set-default-domain")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+	}
+	return
+}
+
+func (cmd *SetDefaultDomain) Run(c *cli.Context) {
+	domain, err := cmd.domainRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding domain", err)
+		return
+	}
+
+	cmd.ui.Say("Setting default domain of space %s to %s...", term.Cyan(cmd.config.Space.Name), term.Cyan(domain.Name))
+
+	cmd.config.SetDefaultDomainForSpace(cmd.config.Space, domain.Name)
+	err = cmd.configRepo.Save()
+	if err != nil {
+		cmd.ui.Failed("Error saving configuration", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
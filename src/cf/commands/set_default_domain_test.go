@@ -0,0 +1,71 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestSetDefaultDomainFailsWithUsage(t *testing.T) {
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callSetDefaultDomain([]string{}, reqFactory, domainRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callSetDefaultDomain([]string{"example.com"}, reqFactory, domainRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestSetDefaultDomainRequirements(t *testing.T) {
+	domainRepo := &testhelpers.FakeDomainRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: false}
+	callSetDefaultDomain([]string{"example.com"}, reqFactory, domainRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestSetDefaultDomain(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+	config, _ := configRepo.Get()
+	config.Space = cf.Space{Name: "my-space", Guid: "my-space-guid"}
+
+	domainRepo := &testhelpers.FakeDomainRepository{
+		FindByNameDomain: cf.Domain{Name: "example.com", Guid: "example-domain-guid"},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callSetDefaultDomain([]string{"Example.com"}, reqFactory, domainRepo)
+
+	assert.Equal(t, domainRepo.FindByNameName, "Example.com")
+	assert.Contains(t, ui.Outputs[0], "Setting default domain")
+	assert.Contains(t, ui.Outputs[0], "my-space")
+	assert.Contains(t, ui.Outputs[0], "example.com")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, testhelpers.SavedConfiguration.DefaultDomainForSpace(config.Space), "example.com")
+}
+
+func TestSetDefaultDomainWhenDomainIsNotMappedToSpace(t *testing.T) {
+	configRepo := testhelpers.FakeConfigRepository{}
+	configRepo.Delete()
+
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameErr: true}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callSetDefaultDomain([]string{"example.com"}, reqFactory, domainRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Error finding domain")
+	assert.Nil(t, testhelpers.SavedConfiguration.DefaultDomains)
+}
+
+func callSetDefaultDomain(args []string, reqFactory *testhelpers.FakeReqFactory, domainRepo *testhelpers.FakeDomainRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("set-default-domain", args)
+	cmd := NewSetDefaultDomain(ui, testhelpers.FakeConfigRepository{}, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type SetOrgRole struct {
+	ui       term.UI
+	orgRepo  api.OrganizationRepository
+	userRepo api.UserRepository
+}
+
+func NewSetOrgRole(ui term.UI, orgRepo api.OrganizationRepository, userRepo api.UserRepository) (cmd *SetOrgRole) {
+	cmd = new(SetOrgRole)
+	cmd.ui = ui
+	cmd.orgRepo = orgRepo
+	cmd.userRepo = userRepo
+	return
+}
+
+func (cmd *SetOrgRole) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 3 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "set-org-role")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *SetOrgRole) Run(c *cli.Context) {
+	user, org, ok := findUserAndOrg(cmd.ui, cmd.userRepo, cmd.orgRepo, c.Args()[0], c.Args()[1])
+	if !ok {
+		return
+	}
+	role := c.Args()[2]
+
+	cmd.ui.Say("Assigning role %s to user %s in org %s...", term.Cyan(role), term.Cyan(user.Username), term.Cyan(org.Name))
+
+	err := cmd.userRepo.SetOrgRole(user, org, role)
+	if err != nil {
+		cmd.ui.Failed("Error assigning role", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
+
+func findUserAndOrg(ui term.UI, userRepo api.UserRepository, orgRepo api.OrganizationRepository, username, orgName string) (user cf.User, org cf.Organization, ok bool) {
+	user, err := userRepo.FindByUsername(username)
+	if err != nil {
+		ui.Failed("Error finding user", err)
+		return
+	}
+
+	org, err = orgRepo.FindByName(orgName)
+	if err != nil {
+		ui.Failed("Error finding org", err)
+		return
+	}
+
+	ok = true
+	return
+}
@@ -0,0 +1,71 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestSetOrgRoleFailsWithUsage(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	userRepo := &testhelpers.FakeUserRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callSetOrgRole([]string{"my-user", "my-org"}, reqFactory, orgRepo, userRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callSetOrgRole([]string{"my-user", "my-org", "OrgManager"}, reqFactory, orgRepo, userRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestSetOrgRoleRequirements(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	userRepo := &testhelpers.FakeUserRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callSetOrgRole([]string{"my-user", "my-org", "OrgManager"}, reqFactory, orgRepo, userRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestSetOrgRole(t *testing.T) {
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	user := cf.User{Username: "my-user", Guid: "my-user-guid"}
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByName: org}
+	userRepo := &testhelpers.FakeUserRepository{FindByUsernameUser: user}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callSetOrgRole([]string{"my-user", "my-org", "OrgManager"}, reqFactory, orgRepo, userRepo)
+
+	assert.Equal(t, userRepo.FindByUsernameUsername, "my-user")
+	assert.Equal(t, orgRepo.OrganizationName, "my-org")
+	assert.Contains(t, ui.Outputs[0], "Assigning role")
+	assert.Contains(t, ui.Outputs[0], "OrgManager")
+	assert.Contains(t, ui.Outputs[0], "my-user")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Equal(t, userRepo.SetOrgRoleUser, user)
+	assert.Equal(t, userRepo.SetOrgRoleOrganization, org)
+	assert.Equal(t, userRepo.SetOrgRoleRole, cf.OrgManager)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestSetOrgRoleWhenUserIsNotFound(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	userRepo := &testhelpers.FakeUserRepository{FindByUsernameNotFound: true}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callSetOrgRole([]string{"my-user", "my-org", "OrgManager"}, reqFactory, orgRepo, userRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Error finding user")
+	assert.Equal(t, userRepo.SetOrgRoleRole, "")
+}
+
+func callSetOrgRole(args []string, reqFactory *testhelpers.FakeReqFactory, orgRepo *testhelpers.FakeOrgRepository, userRepo *testhelpers.FakeUserRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("set-org-role", args)
+	cmd := NewSetOrgRole(ui, orgRepo, userRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
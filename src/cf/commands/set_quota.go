@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type SetQuota struct {
+	ui        term.UI
+	orgRepo   api.OrganizationRepository
+	quotaRepo api.QuotaRepository
+}
+
+func NewSetQuota(ui term.UI, orgRepo api.OrganizationRepository, quotaRepo api.QuotaRepository) (cmd *SetQuota) {
+	cmd = new(SetQuota)
+	cmd.ui = ui
+	cmd.orgRepo = orgRepo
+	cmd.quotaRepo = quotaRepo
+	return
+}
+
+func (cmd *SetQuota) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "set-quota")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *SetQuota) Run(c *cli.Context) {
+	org, err := cmd.orgRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding org", err)
+		return
+	}
+
+	quota, err := cmd.quotaRepo.FindByName(c.Args()[1])
+	if err != nil {
+		cmd.ui.Failed("Error finding quota", err)
+		return
+	}
+
+	cmd.ui.Say("Setting quota %s to org %s...", term.Cyan(quota.Name), term.Cyan(org.Name))
+
+	err = cmd.quotaRepo.AssignQuotaToOrg(org, quota)
+	if err != nil {
+		cmd.ui.Failed("Error setting quota", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,57 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestSetQuotaFailsWithUsage(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callSetQuota([]string{"my-org"}, reqFactory, orgRepo, quotaRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callSetQuota([]string{"my-org", "my-quota"}, reqFactory, orgRepo, quotaRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestSetQuotaRequirements(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callSetQuota([]string{"my-org", "my-quota"}, reqFactory, orgRepo, quotaRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestSetQuota(t *testing.T) {
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	quota := cf.QuotaDefinition{Name: "my-quota", Guid: "my-quota-guid"}
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByName: org}
+	quotaRepo := &testhelpers.FakeQuotaRepository{FindByNameQuota: quota}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callSetQuota([]string{"my-org", "my-quota"}, reqFactory, orgRepo, quotaRepo)
+
+	assert.Equal(t, orgRepo.OrganizationName, "my-org")
+	assert.Equal(t, quotaRepo.FindByNameName, "my-quota")
+	assert.Contains(t, ui.Outputs[0], "Setting quota")
+	assert.Contains(t, ui.Outputs[0], "my-quota")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Equal(t, quotaRepo.AssignQuotaToOrgOrganization, org)
+	assert.Equal(t, quotaRepo.AssignQuotaToOrgQuota, quota)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func callSetQuota(args []string, reqFactory *testhelpers.FakeReqFactory, orgRepo *testhelpers.FakeOrgRepository, quotaRepo *testhelpers.FakeQuotaRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("set-quota", args)
+	cmd := NewSetQuota(ui, orgRepo, quotaRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type SetSpaceRole struct {
+	ui        term.UI
+	config    *configuration.Configuration
+	spaceRepo api.SpaceRepository
+	userRepo  api.UserRepository
+}
+
+func NewSetSpaceRole(ui term.UI, config *configuration.Configuration, spaceRepo api.SpaceRepository, userRepo api.UserRepository) (cmd *SetSpaceRole) {
+	cmd = new(SetSpaceRole)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.spaceRepo = spaceRepo
+	cmd.userRepo = userRepo
+	return
+}
+
+func (cmd *SetSpaceRole) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 3 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "set-space-role")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewOrganizationRequirement(),
+	}
+	return
+}
+
+func (cmd *SetSpaceRole) Run(c *cli.Context) {
+	user, space, ok := findUserAndSpace(cmd.ui, cmd.userRepo, cmd.spaceRepo, c.Args()[0], c.Args()[1])
+	if !ok {
+		return
+	}
+	role := c.Args()[2]
+
+	cmd.ui.Say("Assigning role %s to user %s in org %s / space %s...",
+		term.Cyan(role),
+		term.Cyan(user.Username),
+		term.Cyan(cmd.config.Organization.Name),
+		term.Cyan(space.Name),
+	)
+
+	err := cmd.userRepo.SetSpaceRole(user, space, role)
+	if err != nil {
+		cmd.ui.Failed("Error assigning role", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
+
+func findUserAndSpace(ui term.UI, userRepo api.UserRepository, spaceRepo api.SpaceRepository, username, spaceName string) (user cf.User, space cf.Space, ok bool) {
+	user, err := userRepo.FindByUsername(username)
+	if err != nil {
+		ui.Failed("Error finding user", err)
+		return
+	}
+
+	space, err = spaceRepo.FindByName(spaceName)
+	if err != nil {
+		ui.Failed("Error finding space", err)
+		return
+	}
+
+	ok = true
+	return
+}
@@ -0,0 +1,76 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestSetSpaceRoleFailsWithUsage(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	userRepo := &testhelpers.FakeUserRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callSetSpaceRole([]string{"my-user", "my-space"}, reqFactory, spaceRepo, userRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callSetSpaceRole([]string{"my-user", "my-space", "SpaceManager"}, reqFactory, spaceRepo, userRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestSetSpaceRoleRequirements(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	userRepo := &testhelpers.FakeUserRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: false}
+	callSetSpaceRole([]string{"my-user", "my-space", "SpaceManager"}, reqFactory, spaceRepo, userRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestSetSpaceRole(t *testing.T) {
+	space := cf.Space{Name: "my-space", Guid: "my-space-guid"}
+	user := cf.User{Username: "my-user", Guid: "my-user-guid"}
+	spaceRepo := &testhelpers.FakeSpaceRepository{SpaceByName: space}
+	userRepo := &testhelpers.FakeUserRepository{FindByUsernameUser: user}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callSetSpaceRole([]string{"my-user", "my-space", "SpaceDeveloper"}, reqFactory, spaceRepo, userRepo)
+
+	assert.Equal(t, userRepo.FindByUsernameUsername, "my-user")
+	assert.Equal(t, spaceRepo.SpaceName, "my-space")
+	assert.Contains(t, ui.Outputs[0], "Assigning role")
+	assert.Contains(t, ui.Outputs[0], "SpaceDeveloper")
+	assert.Contains(t, ui.Outputs[0], "my-user")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Contains(t, ui.Outputs[0], "my-space")
+	assert.Equal(t, userRepo.SetSpaceRoleUser, user)
+	assert.Equal(t, userRepo.SetSpaceRoleSpace, space)
+	assert.Equal(t, userRepo.SetSpaceRoleRole, cf.SpaceDeveloper)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestSetSpaceRoleWhenSpaceIsNotFound(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{SpaceByNameErr: true}
+	userRepo := &testhelpers.FakeUserRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callSetSpaceRole([]string{"my-user", "my-space", "SpaceDeveloper"}, reqFactory, spaceRepo, userRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Error finding space")
+	assert.Equal(t, userRepo.SetSpaceRoleRole, "")
+}
+
+func callSetSpaceRole(args []string, reqFactory *testhelpers.FakeReqFactory, spaceRepo *testhelpers.FakeSpaceRepository, userRepo *testhelpers.FakeUserRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	config := &configuration.Configuration{
+		Organization: cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+	}
+	ctxt := testhelpers.NewContext("set-space-role", args)
+	cmd := NewSetSpaceRole(ui, config, spaceRepo, userRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type ShowOrg struct {
+	ui      term.UI
+	orgRepo api.OrganizationRepository
+}
+
+func NewShowOrg(ui term.UI, orgRepo api.OrganizationRepository) (cmd *ShowOrg) {
+	cmd = new(ShowOrg)
+	cmd.ui = ui
+	cmd.orgRepo = orgRepo
+	return
+}
+
+func (cmd *ShowOrg) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "org")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ShowOrg) Run(c *cli.Context) {
+	orgName := c.Args()[0]
+
+	cmd.ui.Say("Getting info for org %s...", term.Cyan(orgName))
+
+	org, err := cmd.orgRepo.FindByName(orgName)
+	if err != nil {
+		cmd.ui.Failed("Error finding org", err)
+		return
+	}
+
+	org, err = cmd.orgRepo.GetSummary(org)
+	if err != nil {
+		cmd.ui.Failed("Error getting org summary", err)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	spaceNames := []string{}
+	for _, space := range org.Spaces {
+		spaceNames = append(spaceNames, space.Name)
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say("%s:", term.Yellow(org.Name))
+	cmd.ui.Say("  domains: %s", domainNames(org.Domains))
+	cmd.ui.Say("  quota: %s", quotaDescription(org.QuotaDefinition))
+	cmd.ui.Say("  spaces: %s", strings.Join(spaceNames, ", "))
+	cmd.ui.Say("  apps: %d", org.AppCount)
+	cmd.ui.Say("  services: %d", org.ServiceCount)
+}
+
+func domainNames(domains []cf.Domain) string {
+	names := []string{}
+	for _, domain := range domains {
+		names = append(names, domain.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func quotaDescription(quota cf.QuotaDefinition) string {
+	if quota.Name == "" {
+		return "none"
+	}
+	return fmt.Sprintf("%s (%dM memory limit)", quota.Name, quota.MemoryLimit)
+}
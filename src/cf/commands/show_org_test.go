@@ -0,0 +1,80 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestShowOrgRequirements(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	callShowOrg([]string{"my-org"}, reqFactory, orgRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callShowOrg([]string{"my-org"}, reqFactory, orgRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestShowOrgFailsWithUsage(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callShowOrg([]string{}, reqFactory, orgRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callShowOrg([]string{"my-org"}, reqFactory, orgRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestShowOrg(t *testing.T) {
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	orgRepo := &testhelpers.FakeOrgRepository{
+		OrganizationByName: org,
+		SummaryOrganization: cf.Organization{
+			Name:            "my-org",
+			Guid:            "my-org-guid",
+			Domains:         []cf.Domain{cf.Domain{Name: "cfapps.io"}, cf.Domain{Name: "example.com"}},
+			QuotaDefinition: cf.QuotaDefinition{Name: "paid", MemoryLimit: 10240},
+			Spaces:          []cf.Space{cf.Space{Name: "development"}, cf.Space{Name: "production"}},
+			AppCount:        5,
+			ServiceCount:    3,
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callShowOrg([]string{"my-org"}, reqFactory, orgRepo)
+
+	assert.Equal(t, orgRepo.OrganizationName, "my-org")
+	assert.Contains(t, ui.Outputs[0], "Getting info for org")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[3], "my-org")
+	assert.Contains(t, ui.Outputs[4], "cfapps.io, example.com")
+	assert.Contains(t, ui.Outputs[5], "paid (10240M memory limit)")
+	assert.Contains(t, ui.Outputs[6], "development, production")
+	assert.Contains(t, ui.Outputs[7], "apps: 5")
+	assert.Contains(t, ui.Outputs[8], "services: 3")
+}
+
+func TestShowOrgWhenOrgIsNotFound(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByNameErr: true}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callShowOrg([]string{"my-org"}, reqFactory, orgRepo)
+
+	assert.Contains(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "Error finding org")
+}
+
+func callShowOrg(args []string, reqFactory *testhelpers.FakeReqFactory, orgRepo *testhelpers.FakeOrgRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("org", args)
+	cmd := NewShowOrg(ui, orgRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type ShowQuota struct {
+	ui        term.UI
+	quotaRepo api.QuotaRepository
+}
+
+func NewShowQuota(ui term.UI, quotaRepo api.QuotaRepository) (cmd *ShowQuota) {
+	cmd = new(ShowQuota)
+	cmd.ui = ui
+	cmd.quotaRepo = quotaRepo
+	return
+}
+
+func (cmd *ShowQuota) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "quota")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ShowQuota) Run(c *cli.Context) {
+	quotaName := c.Args()[0]
+
+	cmd.ui.Say("Getting info for quota %s...", term.Cyan(quotaName))
+
+	quota, err := cmd.quotaRepo.FindByName(quotaName)
+	if err != nil {
+		cmd.ui.Failed("Error finding quota", err)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	cmd.ui.Say("")
+	cmd.ui.Say("%s:", term.Yellow(quota.Name))
+	cmd.ui.Say("  memory limit: %s", formatMegabytes(quota.MemoryLimit))
+	cmd.ui.Say("  services: %d", quota.TotalServices)
+	cmd.ui.Say("  routes: %d", quota.TotalRoutes)
+	cmd.ui.Say("  paid service plans: %s", paidServicePlansDescription(quota))
+}
@@ -0,0 +1,61 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestShowQuotaFailsWithUsage(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callShowQuota([]string{}, reqFactory, quotaRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callShowQuota([]string{"my-quota"}, reqFactory, quotaRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestShowQuota(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{
+		FindByNameQuota: cf.QuotaDefinition{
+			Name:          "my-quota",
+			MemoryLimit:   2048,
+			TotalServices: 10,
+			TotalRoutes:   100,
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callShowQuota([]string{"my-quota"}, reqFactory, quotaRepo)
+
+	assert.Equal(t, quotaRepo.FindByNameName, "my-quota")
+	assert.Contains(t, ui.Outputs[0], "Getting info for quota")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[3], "my-quota")
+	assert.Contains(t, ui.Outputs[4], "memory limit: 2.0G")
+	assert.Contains(t, ui.Outputs[5], "services: 10")
+	assert.Contains(t, ui.Outputs[6], "routes: 100")
+	assert.Contains(t, ui.Outputs[7], "paid service plans: disallowed")
+}
+
+func TestShowQuotaWhenNotFound(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{FindByNameNotFound: true}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callShowQuota([]string{"my-quota"}, reqFactory, quotaRepo)
+
+	assert.Contains(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "Error finding quota")
+}
+
+func callShowQuota(args []string, reqFactory *testhelpers.FakeReqFactory, quotaRepo *testhelpers.FakeQuotaRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("quota", args)
+	cmd := NewShowQuota(ui, quotaRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"cf"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type ShowService struct {
+	ui                 term.UI
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewShowService(ui term.UI) (cmd *ShowService) {
+	cmd = new(ShowService)
+	cmd.ui = ui
+	return
+}
+
+func (cmd *ShowService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "service")
+		return
+	}
+
+	cmd.serviceInstanceReq = reqFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return
+}
+
+func (cmd *ShowService) Run(c *cli.Context) {
+	instance := cmd.serviceInstanceReq.GetServiceInstance()
+
+	cmd.ui.Say("")
+	cmd.ui.Say("%s:", term.Yellow(instance.Name))
+
+	if instance.IsUserProvided() {
+		cmd.ui.Say("  service: user-provided")
+	} else {
+		offering := instance.ServicePlan.ServiceOffering
+		cmd.ui.Say("  service: %s", offering.Label)
+		cmd.ui.Say("  plan: %s", instance.ServicePlan.Name)
+		cmd.ui.Say("  description: %s", offering.Description)
+		cmd.ui.Say("  dashboard: %s", instance.DashboardUrl)
+		cmd.ui.Say("  last operation: %s", lastOperationDescription(instance.LastOperation))
+	}
+
+	cmd.ui.Say("  bound apps: %s", strings.Join(instance.ApplicationNames, ", "))
+}
+
+func lastOperationDescription(operation cf.LastOperation) string {
+	if operation.Type == "" {
+		return "none"
+	}
+
+	description := fmt.Sprintf("%s %s", operation.Type, operation.State)
+	if operation.Description != "" {
+		description = fmt.Sprintf("%s (%s)", description, operation.Description)
+	}
+	return description
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"encoding/json"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type ShowServiceKey struct {
+	ui                 term.UI
+	serviceRepo        api.ServiceRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewShowServiceKey(ui term.UI, serviceRepo api.ServiceRepository) (cmd *ShowServiceKey) {
+	cmd = new(ShowServiceKey)
+	cmd.ui = ui
+	cmd.serviceRepo = serviceRepo
+	return
+}
+
+func (cmd *ShowServiceKey) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "service-key")
+		return
+	}
+
+	cmd.serviceInstanceReq = reqFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return
+}
+
+func (cmd *ShowServiceKey) Run(c *cli.Context) {
+	instance := cmd.serviceInstanceReq.GetServiceInstance()
+	keyName := c.Args()[1]
+
+	cmd.ui.Say("Getting key %s for service instance %s...", term.Cyan(keyName), term.Cyan(instance.Name))
+
+	key, err := cmd.serviceRepo.FindServiceKey(instance, keyName)
+	if err != nil {
+		cmd.ui.Failed("Error getting service key", err)
+		return
+	}
+
+	// Printed as plain JSON so that it can be fed to other tools.
+	credentials, err := json.MarshalIndent(key.Credentials, "", "  ")
+	if err != nil {
+		cmd.ui.Failed("Error reading service key credentials", err)
+		return
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(string(credentials))
+}
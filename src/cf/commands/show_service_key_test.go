@@ -0,0 +1,58 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestShowServiceKeyFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callShowServiceKey([]string{"my-service"}, reqFactory, serviceRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callShowServiceKey([]string{"my-service", "my-key"}, reqFactory, serviceRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestShowServiceKey(t *testing.T) {
+	instance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: instance}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceKeys: []cf.ServiceKey{
+			{Name: "my-key", Credentials: map[string]interface{}{"password": "secret", "port": 3306}},
+		},
+	}
+
+	ui := callShowServiceKey([]string{"my-service", "my-key"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Getting key")
+	assert.Contains(t, ui.Outputs[0], "my-key")
+	assert.Equal(t, ui.Outputs[2], "{\n  \"password\": \"secret\",\n  \"port\": 3306\n}")
+
+	assert.Equal(t, serviceRepo.FindServiceKeyInstance, instance)
+	assert.Equal(t, serviceRepo.FindServiceKeyName, "my-key")
+}
+
+func TestShowServiceKeyWhenKeyIsNotFound(t *testing.T) {
+	instance := cf.ServiceInstance{Name: "my-service", Guid: "my-service-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: instance}
+	serviceRepo := &testhelpers.FakeServiceRepo{FindServiceKeyNotFound: true}
+
+	ui := callShowServiceKey([]string{"my-service", "my-key"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[3], "Service key not found")
+}
+
+func callShowServiceKey(args []string, reqFactory *testhelpers.FakeReqFactory, serviceRepo *testhelpers.FakeServiceRepo) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("service-key", args)
+	cmd := NewShowServiceKey(ui, serviceRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,86 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestShowServiceFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callShowService([]string{}, reqFactory)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callShowService([]string{"my-service"}, reqFactory)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestShowServiceRequirements(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: false}
+	callShowService([]string{"my-service"}, reqFactory)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	callShowService([]string{"my-service"}, reqFactory)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+	assert.Equal(t, reqFactory.ServiceInstanceName, "my-service")
+}
+
+func TestShowService(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{
+		LoginSuccess: true,
+		SpaceSuccess: true,
+		ServiceInstance: cf.ServiceInstance{
+			Name: "my-service",
+			ServicePlan: cf.ServicePlan{
+				Name: "spark",
+				Guid: "plan-guid",
+				ServiceOffering: cf.ServiceOffering{
+					Label:       "cleardb",
+					Description: "A MySQL database",
+				},
+			},
+			DashboardUrl:     "https://dashboard.example.com",
+			LastOperation:    cf.LastOperation{Type: "create", State: "succeeded", Description: "done"},
+			ApplicationNames: []string{"app-1", "app-2"},
+		},
+	}
+
+	ui := callShowService([]string{"my-service"}, reqFactory)
+
+	assert.Contains(t, ui.Outputs[1], "my-service")
+	assert.Contains(t, ui.Outputs[2], "service: cleardb")
+	assert.Contains(t, ui.Outputs[3], "plan: spark")
+	assert.Contains(t, ui.Outputs[4], "description: A MySQL database")
+	assert.Contains(t, ui.Outputs[5], "dashboard: https://dashboard.example.com")
+	assert.Contains(t, ui.Outputs[6], "last operation: create succeeded (done)")
+	assert.Contains(t, ui.Outputs[7], "bound apps: app-1, app-2")
+}
+
+func TestShowUserProvidedService(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{
+		LoginSuccess: true,
+		SpaceSuccess: true,
+		ServiceInstance: cf.ServiceInstance{
+			Name:             "my-service",
+			ApplicationNames: []string{"app-1"},
+		},
+	}
+
+	ui := callShowService([]string{"my-service"}, reqFactory)
+
+	assert.Equal(t, len(ui.Outputs), 4)
+	assert.Contains(t, ui.Outputs[2], "service: user-provided")
+	assert.Contains(t, ui.Outputs[3], "bound apps: app-1")
+}
+
+func callShowService(args []string, reqFactory *testhelpers.FakeReqFactory) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("service", args)
+	cmd := NewShowService(ui)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
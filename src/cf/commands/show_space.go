@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type ShowSpace struct {
+	ui         term.UI
+	config     *configuration.Configuration
+	orgRepo    api.OrganizationRepository
+	spaceRepo  api.SpaceRepository
+	domainRepo api.DomainRepository
+}
+
+func NewShowSpace(ui term.UI, config *configuration.Configuration, orgRepo api.OrganizationRepository, spaceRepo api.SpaceRepository, domainRepo api.DomainRepository) (cmd *ShowSpace) {
+	cmd = new(ShowSpace)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.orgRepo = orgRepo
+	cmd.spaceRepo = spaceRepo
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *ShowSpace) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "space")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewOrganizationRequirement(),
+	}
+	return
+}
+
+func (cmd *ShowSpace) Run(c *cli.Context) {
+	spaceName := c.Args()[0]
+
+	cmd.ui.Say("Getting info for space %s in org %s...", term.Cyan(spaceName), term.Cyan(cmd.config.Organization.Name))
+
+	space, err := cmd.spaceRepo.FindByName(spaceName)
+	if err != nil {
+		cmd.ui.Failed("Error finding space", err)
+		return
+	}
+
+	summary, err := cmd.spaceRepo.GetSpaceSummary(space)
+	if err != nil {
+		cmd.ui.Failed("Error getting space summary", err)
+		return
+	}
+
+	domains, err := cmd.domainRepo.FindAllInSpace(space)
+	if err != nil {
+		cmd.ui.Failed("Error getting domains", err)
+		return
+	}
+
+	// Spaces have no quota of their own, the org quota applies to them.
+	org, err := cmd.orgRepo.GetSummary(cmd.config.Organization)
+	if err != nil {
+		cmd.ui.Failed("Error getting org summary", err)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	cmd.ui.Say("")
+	cmd.ui.Say("%s:", term.Yellow(space.Name))
+	cmd.ui.Say("  org: %s", cmd.config.Organization.Name)
+	cmd.ui.Say("  domains: %s", domainNames(domains))
+	cmd.ui.Say("  quota: %s", quotaDescription(org.QuotaDefinition))
+	cmd.ui.Say("  apps: %d", len(summary.Applications))
+	cmd.ui.Say("  services: %d", len(summary.ServiceInstances))
+}
@@ -0,0 +1,83 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestShowSpaceRequirements(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+	callShowSpace([]string{"my-space"}, reqFactory, spaceRepo, domainRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: false}
+	callShowSpace([]string{"my-space"}, reqFactory, spaceRepo, domainRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestShowSpaceFailsWithUsage(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callShowSpace([]string{}, reqFactory, spaceRepo, domainRepo)
+	assert.True(t, ui.FailedWithUsage)
+}
+
+func TestShowSpace(t *testing.T) {
+	space := cf.Space{Name: "my-space", Guid: "my-space-guid"}
+	spaceRepo := &testhelpers.FakeSpaceRepository{
+		SpaceByName: space,
+		SpaceSummarySpace: cf.Space{
+			Name:             "my-space",
+			Applications:     []cf.Application{cf.Application{Name: "app1"}, cf.Application{Name: "app2"}},
+			ServiceInstances: []cf.ServiceInstance{cf.ServiceInstance{Name: "my-db"}},
+		},
+	}
+	domainRepo := &testhelpers.FakeDomainRepository{
+		FindAllInSpaceDomains: []cf.Domain{cf.Domain{Name: "cfapps.io"}},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+	orgRepo := &testhelpers.FakeOrgRepository{
+		SummaryOrganization: cf.Organization{
+			QuotaDefinition: cf.QuotaDefinition{Name: "small", MemoryLimit: 1024},
+		},
+	}
+
+	ui := callShowSpaceWithOrgRepo([]string{"my-space"}, reqFactory, orgRepo, spaceRepo, domainRepo)
+
+	assert.Equal(t, spaceRepo.SpaceName, "my-space")
+	assert.Equal(t, domainRepo.FindAllInSpaceSpace, space)
+	assert.Contains(t, ui.Outputs[0], "Getting info for space")
+	assert.Contains(t, ui.Outputs[0], "my-space")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[3], "my-space")
+	assert.Contains(t, ui.Outputs[4], "org: my-org")
+	assert.Contains(t, ui.Outputs[5], "domains: cfapps.io")
+	assert.Contains(t, ui.Outputs[6], "quota: small (1024M memory limit)")
+	assert.Contains(t, ui.Outputs[7], "apps: 2")
+	assert.Contains(t, ui.Outputs[8], "services: 1")
+}
+
+func callShowSpace(args []string, reqFactory *testhelpers.FakeReqFactory, spaceRepo *testhelpers.FakeSpaceRepository, domainRepo *testhelpers.FakeDomainRepository) (ui *testhelpers.FakeUI) {
+	return callShowSpaceWithOrgRepo(args, reqFactory, &testhelpers.FakeOrgRepository{}, spaceRepo, domainRepo)
+}
+
+func callShowSpaceWithOrgRepo(args []string, reqFactory *testhelpers.FakeReqFactory, orgRepo *testhelpers.FakeOrgRepository, spaceRepo *testhelpers.FakeSpaceRepository, domainRepo *testhelpers.FakeDomainRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	config := &configuration.Configuration{
+		Organization: cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+	}
+	ctxt := testhelpers.NewContext("space", args)
+	cmd := NewShowSpace(ui, config, orgRepo, spaceRepo, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type SpaceUsers struct {
+	ui        term.UI
+	config    *configuration.Configuration
+	spaceRepo api.SpaceRepository
+	userRepo  api.UserRepository
+}
+
+func NewSpaceUsers(ui term.UI, config *configuration.Configuration, spaceRepo api.SpaceRepository, userRepo api.UserRepository) (cmd *SpaceUsers) {
+	cmd = new(SpaceUsers)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.spaceRepo = spaceRepo
+	cmd.userRepo = userRepo
+	return
+}
+
+func (cmd *SpaceUsers) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "space-users")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewOrganizationRequirement(),
+	}
+	return
+}
+
+func (cmd *SpaceUsers) Run(c *cli.Context) {
+	space, err := cmd.spaceRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding space", err)
+		return
+	}
+
+	cmd.ui.Say("Getting users in org %s / space %s as %s...",
+		term.Cyan(cmd.config.Organization.Name),
+		term.Cyan(space.Name),
+		term.Cyan(cmd.config.UserEmail()),
+	)
+
+	usersByRole, err := cmd.userRepo.FindAllInSpaceByRole(space)
+	if err != nil {
+		cmd.ui.Failed("Error getting users", err)
+		return
+	}
+
+	cmd.ui.Ok()
+	displayUsersByRole(cmd.ui, cf.SpaceRoles, usersByRole)
+}
@@ -0,0 +1,67 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestSpaceUsersFailsWithUsage(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	userRepo := &testhelpers.FakeUserRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callSpaceUsers([]string{}, reqFactory, spaceRepo, userRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callSpaceUsers([]string{"my-space"}, reqFactory, spaceRepo, userRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestSpaceUsersRequirements(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	userRepo := &testhelpers.FakeUserRepository{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: false}
+	callSpaceUsers([]string{"my-space"}, reqFactory, spaceRepo, userRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestSpaceUsers(t *testing.T) {
+	space := cf.Space{Name: "my-space", Guid: "my-space-guid"}
+	spaceRepo := &testhelpers.FakeSpaceRepository{SpaceByName: space}
+	userRepo := &testhelpers.FakeUserRepository{
+		UsersByRole: map[string][]cf.User{
+			cf.SpaceDeveloper: []cf.User{cf.User{Username: "user1"}},
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callSpaceUsers([]string{"my-space"}, reqFactory, spaceRepo, userRepo)
+
+	assert.Equal(t, spaceRepo.SpaceName, "my-space")
+	assert.Equal(t, userRepo.FindAllInSpaceByRoleSpace, space)
+	assert.Contains(t, ui.Outputs[0], "Getting users in org")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Contains(t, ui.Outputs[0], "my-space")
+	assert.Contains(t, ui.Outputs[1], "OK")
+
+	assert.Contains(t, ui.Outputs[3], "SpaceManager")
+	assert.Contains(t, ui.Outputs[4], "none")
+	assert.Contains(t, ui.Outputs[6], "SpaceDeveloper")
+	assert.Contains(t, ui.Outputs[7], "user1")
+	assert.Contains(t, ui.Outputs[9], "SpaceAuditor")
+	assert.Contains(t, ui.Outputs[10], "none")
+}
+
+func callSpaceUsers(args []string, reqFactory *testhelpers.FakeReqFactory, spaceRepo *testhelpers.FakeSpaceRepository, userRepo *testhelpers.FakeUserRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	config := *testhelpers.FakeConfigRepository{}.Login()
+	config.Organization = cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	ctxt := testhelpers.NewContext("space-users", args)
+	cmd := NewSpaceUsers(ui, &config, spaceRepo, userRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
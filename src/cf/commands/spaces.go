@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type Spaces struct {
+	ui        term.UI
+	config    *configuration.Configuration
+	spaceRepo api.SpaceRepository
+}
+
+func NewSpaces(ui term.UI, config *configuration.Configuration, spaceRepo api.SpaceRepository) (cmd *Spaces) {
+	cmd = new(Spaces)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.spaceRepo = spaceRepo
+	return
+}
+
+func (cmd *Spaces) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewOrganizationRequirement(),
+	}
+	return
+}
+
+func (cmd *Spaces) Run(c *cli.Context) {
+	cmd.ui.Say("Getting spaces in org %s as %s...",
+		term.Cyan(cmd.config.Organization.Name),
+		term.Cyan(cmd.config.UserEmail()),
+	)
+
+	spaces, err := cmd.spaceRepo.FindAll()
+	if err != nil {
+		cmd.ui.Failed("Error getting spaces", err)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	if len(spaces) == 0 {
+		cmd.ui.Say("No spaces found")
+		return
+	}
+
+	table := [][]string{
+		[]string{"name", "targeted"},
+	}
+
+	for _, space := range spaces {
+		table = append(table, []string{
+			space.Name,
+			targetedMarker(space.Guid == cmd.config.Space.Guid),
+		})
+	}
+
+	cmd.ui.DisplayTable(table, nil)
+}
@@ -0,0 +1,59 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestSpacesRequirements(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	config := &configuration.Configuration{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+	callSpaces(reqFactory, config, spaceRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: false}
+	callSpaces(reqFactory, config, spaceRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: false, OrgSuccess: true}
+	callSpaces(reqFactory, config, spaceRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestListingSpaces(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{
+		Spaces: []cf.Space{
+			cf.Space{Name: "space1", Guid: "space-1-guid"},
+			cf.Space{Name: "space2", Guid: "space-2-guid"},
+		},
+	}
+	config := &configuration.Configuration{
+		Organization: cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+		Space:        cf.Space{Name: "space1", Guid: "space-1-guid"},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callSpaces(reqFactory, config, spaceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Getting spaces in org")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[3], "space1")
+	assert.Contains(t, ui.Outputs[3], "yes")
+	assert.Contains(t, ui.Outputs[4], "space2")
+	assert.NotContains(t, ui.Outputs[4], "yes")
+}
+
+func callSpaces(reqFactory *testhelpers.FakeReqFactory, config *configuration.Configuration, spaceRepo *testhelpers.FakeSpaceRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("spaces", []string{})
+	cmd := NewSpaces(ui, config, spaceRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
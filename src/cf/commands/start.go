@@ -22,7 +22,7 @@ type Start struct {
 }
 
 type ApplicationStarter interface {
-	ApplicationStart(cf.Application)
+	ApplicationStart(cf.Application) (err error)
 }
 
 func NewStart(ui term.UI, config *configuration.Configuration, appRepo api.ApplicationRepository) (s *Start) {
@@ -51,7 +51,9 @@ func (s *Start) Run(c *cli.Context) {
 	s.ApplicationStart(s.appReq.GetApplication())
 }
 
-func (s *Start) ApplicationStart(app cf.Application) {
+// ApplicationStart starts the app and waits for its instances to come up.
+// It returns an error when the app fails to stage or to start.
+func (s *Start) ApplicationStart(app cf.Application) (err error) {
 	if app.State == "started" {
 		s.ui.Say(term.Magenta("Application " + app.Name + " is already started."))
 		return
@@ -59,7 +61,7 @@ func (s *Start) ApplicationStart(app cf.Application) {
 
 	s.ui.Say("Starting %s...", term.Cyan(app.Name))
 
-	err := s.appRepo.Start(app)
+	err = s.appRepo.Start(app)
 	if err != nil {
 		s.ui.Failed("Error starting application.", err)
 		return
@@ -85,13 +87,16 @@ func (s *Start) ApplicationStart(app cf.Application) {
 
 	s.startTime = time.Now()
 
-	for s.displayInstancesStatus(app, instances) {
+	notFinished, err := s.displayInstancesStatus(app, instances)
+	for notFinished {
 		s.ui.Wait(1 * time.Second)
 		instances, _, _ = s.appRepo.GetInstances(app)
+		notFinished, err = s.displayInstancesStatus(app, instances)
 	}
+	return
 }
 
-func (s Start) displayInstancesStatus(app cf.Application, instances []cf.ApplicationInstance) (notFinished bool) {
+func (s Start) displayInstancesStatus(app cf.Application, instances []cf.ApplicationInstance) (notFinished bool, err error) {
 	totalCount := len(instances)
 	runningCount, startingCount, flappingCount, downCount := 0, 0, 0, 0
 
@@ -109,8 +114,9 @@ func (s Start) displayInstancesStatus(app cf.Application, instances []cf.Applica
 	}
 
 	if flappingCount > 0 {
-		s.ui.Failed("Start unsuccessful", nil)
-		return false
+		err = errors.New("Start unsuccessful")
+		s.ui.Failed(err.Error(), nil)
+		return
 	}
 
 	anyInstanceRunning := runningCount > 0
@@ -121,18 +127,20 @@ func (s Start) displayInstancesStatus(app cf.Application, instances []cf.Applica
 		} else {
 			s.ui.Say("Start successful! App %s available at %s", app.Name, app.Urls[0])
 		}
-		return false
+		return
 	} else {
 		details := instancesDetails(runningCount, startingCount, downCount)
 		s.ui.Say("%d of %d instances running (%s)", runningCount, totalCount, details)
 	}
 
 	if time.Since(s.startTime) > s.config.ApplicationStartTimeout*time.Second {
-		s.ui.Failed("Start app timeout", nil)
-		return false
+		err = errors.New("Start app timeout")
+		s.ui.Failed(err.Error(), nil)
+		return
 	}
 
-	return totalCount > runningCount
+	notFinished = totalCount > runningCount
+	return
 }
 
 func instancesDetails(runningCount int, startingCount int, downCount int) string {
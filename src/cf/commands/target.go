@@ -6,6 +6,7 @@ import (
 	"cf/configuration"
 	"cf/requirements"
 	term "cf/terminal"
+	"errors"
 	"github.com/codegangsta/cli"
 )
 
@@ -14,6 +15,12 @@ type InfoResponse struct {
 	AuthorizationEndpoint string `json:"authorization_endpoint"`
 }
 
+// ApiEndpointSetter points the CLI at another API endpoint, reporting
+// progress and failures itself.
+type ApiEndpointSetter interface {
+	SetApiEndpoint(endpoint string) (err error)
+}
+
 type Target struct {
 	ui         term.UI
 	config     *configuration.Configuration
@@ -57,7 +64,7 @@ func (t Target) Run(c *cli.Context) {
 	}
 
 	if argsCount > 0 {
-		t.setNewTarget(c.Args()[0])
+		t.SetApiEndpoint(c.Args()[0])
 		return
 	}
 
@@ -77,7 +84,7 @@ func (t Target) Run(c *cli.Context) {
 	return
 }
 
-func (t Target) setNewTarget(target string) {
+func (t Target) SetApiEndpoint(target string) (err error) {
 	t.ui.Say("Setting target to %s...", term.Yellow(target))
 
 	request, err := api.NewRequest("GET", target+"/v2/info", "", nil)
@@ -89,7 +96,8 @@ func (t Target) setNewTarget(target string) {
 
 	scheme := request.URL.Scheme
 	if scheme != "http" && scheme != "https" {
-		t.ui.Failed("API Endpoints should start with https:// or http://", nil)
+		err = errors.New("API Endpoints should start with https:// or http://")
+		t.ui.Failed(err.Error(), nil)
 		return
 	}
 
@@ -114,6 +122,7 @@ func (t Target) setNewTarget(target string) {
 		t.ui.Say(term.Magenta("\nWarning: Insecure http API Endpoint detected. Secure https API Endpoints are recommended.\n"))
 	}
 	t.ui.ShowConfiguration(t.config)
+	return
 }
 
 func (t *Target) saveTarget(target string, info *InfoResponse) (err error) {
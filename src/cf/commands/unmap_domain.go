@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type UnmapDomain struct {
+	ui         term.UI
+	spaceRepo  api.SpaceRepository
+	domainRepo api.DomainRepository
+}
+
+func NewUnmapDomain(ui term.UI, spaceRepo api.SpaceRepository, domainRepo api.DomainRepository) (cmd *UnmapDomain) {
+	cmd = new(UnmapDomain)
+	cmd.ui = ui
+	cmd.spaceRepo = spaceRepo
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *UnmapDomain) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "unmap-domain")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewOrganizationRequirement(),
+	}
+	return
+}
+
+func (cmd *UnmapDomain) Run(c *cli.Context) {
+	spaceName := c.Args()[0]
+	domainName := c.Args()[1]
+
+	space, err := cmd.spaceRepo.FindByName(spaceName)
+	if err != nil {
+		cmd.ui.Failed("Error finding space", err)
+		return
+	}
+
+	domain, err := cmd.domainRepo.FindByNameInCurrentOrg(domainName)
+	if err != nil {
+		cmd.ui.Failed("Error finding domain", err)
+		return
+	}
+
+	cmd.ui.Say("Unmapping domain %s from space %s...", term.Cyan(domain.Name), term.Cyan(space.Name))
+
+	err = cmd.domainRepo.UnmapDomain(domain, space)
+	if err != nil {
+		cmd.ui.Failed("Error unmapping domain", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,46 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestUnmapDomainFailsWithUsage(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callUnmapDomain([]string{"my-space"}, reqFactory, spaceRepo, domainRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callUnmapDomain([]string{"my-space", "example.com"}, reqFactory, spaceRepo, domainRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestUnmapDomain(t *testing.T) {
+	space := cf.Space{Name: "my-space", Guid: "my-space-guid"}
+	domain := cf.Domain{Name: "example.com", Guid: "example-domain-guid"}
+	spaceRepo := &testhelpers.FakeSpaceRepository{SpaceByName: space}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameInCurrentOrgDomain: domain}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callUnmapDomain([]string{"my-space", "example.com"}, reqFactory, spaceRepo, domainRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Unmapping domain")
+	assert.Contains(t, ui.Outputs[0], "example.com")
+	assert.Contains(t, ui.Outputs[0], "my-space")
+	assert.Equal(t, domainRepo.UnmapDomainDomain, domain)
+	assert.Equal(t, domainRepo.UnmapDomainSpace, space)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func callUnmapDomain(args []string, reqFactory *testhelpers.FakeReqFactory, spaceRepo *testhelpers.FakeSpaceRepository, domainRepo *testhelpers.FakeDomainRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("unmap-domain", args)
+	cmd := NewUnmapDomain(ui, spaceRepo, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
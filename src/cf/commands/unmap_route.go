@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+)
+
+type UnmapRoute struct {
+	ui         term.UI
+	routeRepo  api.RouteRepository
+	domainRepo api.DomainRepository
+	appReq     requirements.ApplicationRequirement
+}
+
+func NewUnmapRoute(ui term.UI, routeRepo api.RouteRepository, domainRepo api.DomainRepository) (cmd *UnmapRoute) {
+	cmd = new(UnmapRoute)
+	cmd.ui = ui
+	cmd.routeRepo = routeRepo
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *UnmapRoute) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 || c.String("n") == "" {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "unmap-route")
+		return
+	}
+
+	cmd.appReq = reqFactory.NewApplicationRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+		cmd.appReq,
+	}
+	return
+}
+
+func (cmd *UnmapRoute) Run(c *cli.Context) {
+	app := cmd.appReq.GetApplication()
+
+	domain, err := cmd.domainRepo.FindByName(c.Args()[1])
+	if err != nil {
+		cmd.ui.Failed("Error finding domain", err)
+		return
+	}
+
+	route, err := cmd.routeRepo.FindByHostAndDomain(c.String("n"), domain)
+	if err != nil {
+		requestedRoute := cf.Route{Host: c.String("n"), Domain: domain}
+		cmd.ui.Failed(fmt.Sprintf("Error finding route %s", requestedRoute.URL()), err)
+		return
+	}
+
+	cmd.ui.Say("Removing route %s from %s...", term.Cyan(route.URL()), term.Cyan(app.Name))
+
+	err = cmd.routeRepo.Unbind(route, app)
+	if err != nil {
+		cmd.ui.Failed("Error removing route", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
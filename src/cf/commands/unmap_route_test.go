@@ -0,0 +1,60 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestUnmapRouteFailsWithUsage(t *testing.T) {
+	routeRepo := &testhelpers.FakeRouteRepository{}
+	domainRepo := &testhelpers.FakeDomainRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callUnmapRoute([]string{"my-app", "example.com"}, reqFactory, routeRepo, domainRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callUnmapRoute([]string{"-n", "my-host", "my-app", "example.com"}, reqFactory, routeRepo, domainRepo)
+	assert.False(t, ui.FailedWithUsage)
+	assert.Equal(t, reqFactory.ApplicationName, "my-app")
+}
+
+func TestUnmapRoute(t *testing.T) {
+	domain := cf.Domain{Name: "example.com", Guid: "example-domain-guid"}
+	route := cf.Route{Host: "my-host", Guid: "my-route-guid", Domain: domain}
+	routeRepo := &testhelpers.FakeRouteRepository{FindByHostAndDomainRoute: route}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	app := cf.Application{Name: "my-app", Guid: "my-app-guid"}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, Application: app}
+
+	ui := callUnmapRoute([]string{"-n", "my-host", "my-app", "example.com"}, reqFactory, routeRepo, domainRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Removing route")
+	assert.Contains(t, ui.Outputs[0], "my-host.example.com")
+	assert.Equal(t, routeRepo.UnboundRoute, route)
+	assert.Equal(t, routeRepo.UnboundApp, app)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestUnmapRouteWhenRouteDoesNotExist(t *testing.T) {
+	domain := cf.Domain{Name: "example.com", Guid: "example-domain-guid"}
+	routeRepo := &testhelpers.FakeRouteRepository{FindByHostAndDomainErr: true}
+	domainRepo := &testhelpers.FakeDomainRepository{FindByNameDomain: domain}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+
+	ui := callUnmapRoute([]string{"-n", "my-host", "my-app", "example.com"}, reqFactory, routeRepo, domainRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Error finding route my-host.example.com")
+	assert.Equal(t, routeRepo.UnboundRoute.Guid, "")
+}
+
+func callUnmapRoute(args []string, reqFactory *testhelpers.FakeReqFactory, routeRepo *testhelpers.FakeRouteRepository, domainRepo *testhelpers.FakeDomainRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("unmap-route", args)
+	cmd := NewUnmapRoute(ui, routeRepo, domainRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
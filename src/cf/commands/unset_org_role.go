@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type UnsetOrgRole struct {
+	ui       term.UI
+	orgRepo  api.OrganizationRepository
+	userRepo api.UserRepository
+}
+
+func NewUnsetOrgRole(ui term.UI, orgRepo api.OrganizationRepository, userRepo api.UserRepository) (cmd *UnsetOrgRole) {
+	cmd = new(UnsetOrgRole)
+	cmd.ui = ui
+	cmd.orgRepo = orgRepo
+	cmd.userRepo = userRepo
+	return
+}
+
+func (cmd *UnsetOrgRole) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 3 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "unset-org-role")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *UnsetOrgRole) Run(c *cli.Context) {
+	user, org, ok := findUserAndOrg(cmd.ui, cmd.userRepo, cmd.orgRepo, c.Args()[0], c.Args()[1])
+	if !ok {
+		return
+	}
+	role := c.Args()[2]
+
+	cmd.ui.Say("Removing role %s from user %s in org %s...", term.Cyan(role), term.Cyan(user.Username), term.Cyan(org.Name))
+
+	err := cmd.userRepo.UnsetOrgRole(user, org, role)
+	if err != nil {
+		cmd.ui.Failed("Error removing role", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
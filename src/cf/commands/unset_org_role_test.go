@@ -0,0 +1,48 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestUnsetOrgRoleFailsWithUsage(t *testing.T) {
+	orgRepo := &testhelpers.FakeOrgRepository{}
+	userRepo := &testhelpers.FakeUserRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callUnsetOrgRole([]string{"my-user", "my-org"}, reqFactory, orgRepo, userRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callUnsetOrgRole([]string{"my-user", "my-org", "OrgManager"}, reqFactory, orgRepo, userRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestUnsetOrgRole(t *testing.T) {
+	org := cf.Organization{Name: "my-org", Guid: "my-org-guid"}
+	user := cf.User{Username: "my-user", Guid: "my-user-guid"}
+	orgRepo := &testhelpers.FakeOrgRepository{OrganizationByName: org}
+	userRepo := &testhelpers.FakeUserRepository{FindByUsernameUser: user}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callUnsetOrgRole([]string{"my-user", "my-org", "BillingManager"}, reqFactory, orgRepo, userRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Removing role")
+	assert.Contains(t, ui.Outputs[0], "BillingManager")
+	assert.Contains(t, ui.Outputs[0], "my-user")
+	assert.Contains(t, ui.Outputs[0], "my-org")
+	assert.Equal(t, userRepo.UnsetOrgRoleUser, user)
+	assert.Equal(t, userRepo.UnsetOrgRoleOrganization, org)
+	assert.Equal(t, userRepo.UnsetOrgRoleRole, cf.BillingManager)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func callUnsetOrgRole(args []string, reqFactory *testhelpers.FakeReqFactory, orgRepo *testhelpers.FakeOrgRepository, userRepo *testhelpers.FakeUserRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("unset-org-role", args)
+	cmd := NewUnsetOrgRole(ui, orgRepo, userRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type UnsetSpaceRole struct {
+	ui        term.UI
+	config    *configuration.Configuration
+	spaceRepo api.SpaceRepository
+	userRepo  api.UserRepository
+}
+
+func NewUnsetSpaceRole(ui term.UI, config *configuration.Configuration, spaceRepo api.SpaceRepository, userRepo api.UserRepository) (cmd *UnsetSpaceRole) {
+	cmd = new(UnsetSpaceRole)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.spaceRepo = spaceRepo
+	cmd.userRepo = userRepo
+	return
+}
+
+func (cmd *UnsetSpaceRole) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 3 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "unset-space-role")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewOrganizationRequirement(),
+	}
+	return
+}
+
+func (cmd *UnsetSpaceRole) Run(c *cli.Context) {
+	user, space, ok := findUserAndSpace(cmd.ui, cmd.userRepo, cmd.spaceRepo, c.Args()[0], c.Args()[1])
+	if !ok {
+		return
+	}
+	role := c.Args()[2]
+
+	cmd.ui.Say("Removing role %s from user %s in org %s / space %s...",
+		term.Cyan(role),
+		term.Cyan(user.Username),
+		term.Cyan(cmd.config.Organization.Name),
+		term.Cyan(space.Name),
+	)
+
+	err := cmd.userRepo.UnsetSpaceRole(user, space, role)
+	if err != nil {
+		cmd.ui.Failed("Error removing role", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
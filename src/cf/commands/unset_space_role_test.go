@@ -0,0 +1,52 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestUnsetSpaceRoleFailsWithUsage(t *testing.T) {
+	spaceRepo := &testhelpers.FakeSpaceRepository{}
+	userRepo := &testhelpers.FakeUserRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callUnsetSpaceRole([]string{"my-user", "my-space"}, reqFactory, spaceRepo, userRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callUnsetSpaceRole([]string{"my-user", "my-space", "SpaceManager"}, reqFactory, spaceRepo, userRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestUnsetSpaceRole(t *testing.T) {
+	space := cf.Space{Name: "my-space", Guid: "my-space-guid"}
+	user := cf.User{Username: "my-user", Guid: "my-user-guid"}
+	spaceRepo := &testhelpers.FakeSpaceRepository{SpaceByName: space}
+	userRepo := &testhelpers.FakeUserRepository{FindByUsernameUser: user}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, OrgSuccess: true}
+
+	ui := callUnsetSpaceRole([]string{"my-user", "my-space", "SpaceAuditor"}, reqFactory, spaceRepo, userRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Removing role")
+	assert.Contains(t, ui.Outputs[0], "SpaceAuditor")
+	assert.Contains(t, ui.Outputs[0], "my-user")
+	assert.Contains(t, ui.Outputs[0], "my-space")
+	assert.Equal(t, userRepo.UnsetSpaceRoleUser, user)
+	assert.Equal(t, userRepo.UnsetSpaceRoleSpace, space)
+	assert.Equal(t, userRepo.UnsetSpaceRoleRole, cf.SpaceAuditor)
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func callUnsetSpaceRole(args []string, reqFactory *testhelpers.FakeReqFactory, spaceRepo *testhelpers.FakeSpaceRepository, userRepo *testhelpers.FakeUserRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	config := &configuration.Configuration{
+		Organization: cf.Organization{Name: "my-org", Guid: "my-org-guid"},
+	}
+	ctxt := testhelpers.NewContext("unset-space-role", args)
+	cmd := NewUnsetSpaceRole(ui, config, spaceRepo, userRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
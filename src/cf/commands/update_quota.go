@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/formatters"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"strconv"
+)
+
+type UpdateQuota struct {
+	ui        term.UI
+	quotaRepo api.QuotaRepository
+}
+
+func NewUpdateQuota(ui term.UI, quotaRepo api.QuotaRepository) (cmd *UpdateQuota) {
+	cmd = new(UpdateQuota)
+	cmd.ui = ui
+	cmd.quotaRepo = quotaRepo
+	return
+}
+
+func (cmd *UpdateQuota) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "update-quota")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+// Only the limits given as flags are changed; the others keep their
+// current values.
+func (cmd *UpdateQuota) Run(c *cli.Context) {
+	if c.Bool("allow-paid-service-plans") && c.Bool("disallow-paid-service-plans") {
+		err := errors.New("allow-paid-service-plans and disallow-paid-service-plans cannot be used together")
+		cmd.ui.Failed("Error reading quota options", err)
+		return
+	}
+
+	quota, err := cmd.quotaRepo.FindByName(c.Args()[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding quota", err)
+		return
+	}
+
+	oldName := quota.Name
+
+	if c.String("n") != "" {
+		quota.Name = c.String("n")
+	}
+
+	if c.String("m") != "" {
+		quota.MemoryLimit, err = formatters.ToMegabytes(c.String("m"))
+		if err != nil {
+			cmd.ui.Failed("Invalid memory limit", err)
+			return
+		}
+	}
+
+	if c.String("s") != "" {
+		quota.TotalServices, err = parseQuotaLimit(c.String("s"))
+		if err != nil {
+			cmd.ui.Failed("Invalid services limit", err)
+			return
+		}
+	}
+
+	if c.String("r") != "" {
+		quota.TotalRoutes, err = parseQuotaLimit(c.String("r"))
+		if err != nil {
+			cmd.ui.Failed("Invalid routes limit", err)
+			return
+		}
+	}
+
+	if c.Bool("allow-paid-service-plans") {
+		quota.NonBasicServicesAllowed = true
+	}
+	if c.Bool("disallow-paid-service-plans") {
+		quota.NonBasicServicesAllowed = false
+	}
+
+	cmd.ui.Say("Updating quota %s...", term.Cyan(oldName))
+
+	err = cmd.quotaRepo.Update(quota)
+	if err != nil {
+		cmd.ui.Failed("Error updating quota", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
+
+// unlimitedQuota is the limit of a resource a quota does not limit.
+const unlimitedQuota = -1
+
+func parseQuotaLimit(arg string) (limit int, err error) {
+	limit, err = strconv.Atoi(arg)
+	if err != nil || limit < unlimitedQuota {
+		err = errors.New(fmt.Sprintf("%s is not a valid limit", arg))
+	}
+	return
+}
@@ -0,0 +1,103 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestUpdateQuotaFailsWithUsage(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callUpdateQuota([]string{}, reqFactory, quotaRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callUpdateQuota([]string{"my-quota"}, reqFactory, quotaRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestUpdateQuotaOnlyChangesGivenLimits(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{
+		FindByNameQuota: cf.QuotaDefinition{
+			Name:          "my-quota",
+			Guid:          "my-quota-guid",
+			MemoryLimit:   1024,
+			TotalServices: 10,
+			TotalRoutes:   100,
+		},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callUpdateQuota([]string{"-m", "4G", "-r", "200", "--allow-paid-service-plans", "my-quota"}, reqFactory, quotaRepo)
+
+	assert.Equal(t, quotaRepo.FindByNameName, "my-quota")
+	assert.Contains(t, ui.Outputs[0], "Updating quota")
+	assert.Contains(t, ui.Outputs[0], "my-quota")
+	assert.Equal(t, quotaRepo.UpdatedQuota, cf.QuotaDefinition{
+		Name:                    "my-quota",
+		Guid:                    "my-quota-guid",
+		MemoryLimit:             4096,
+		TotalServices:           10,
+		TotalRoutes:             200,
+		NonBasicServicesAllowed: true,
+	})
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestUpdateQuotaRenamesAndDisallowsPaidPlans(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{
+		FindByNameQuota: cf.QuotaDefinition{Name: "my-quota", Guid: "my-quota-guid", NonBasicServicesAllowed: true},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	callUpdateQuota([]string{"-n", "new-quota", "--disallow-paid-service-plans", "my-quota"}, reqFactory, quotaRepo)
+
+	assert.Equal(t, quotaRepo.UpdatedQuota.Name, "new-quota")
+	assert.False(t, quotaRepo.UpdatedQuota.NonBasicServicesAllowed)
+}
+
+func TestUpdateQuotaWithConflictingPaidPlanFlags(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callUpdateQuota([]string{"--allow-paid-service-plans", "--disallow-paid-service-plans", "my-quota"}, reqFactory, quotaRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "cannot be used together")
+	assert.Equal(t, quotaRepo.UpdatedQuota.Guid, "")
+}
+
+func TestUpdateQuotaToUnlimitedServices(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{
+		FindByNameQuota: cf.QuotaDefinition{Name: "my-quota", Guid: "my-quota-guid", TotalServices: 10},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	callUpdateQuota([]string{"-s", "-1", "my-quota"}, reqFactory, quotaRepo)
+
+	assert.Equal(t, quotaRepo.UpdatedQuota.TotalServices, -1)
+}
+
+func TestUpdateQuotaWithInvalidLimit(t *testing.T) {
+	quotaRepo := &testhelpers.FakeQuotaRepository{
+		FindByNameQuota: cf.QuotaDefinition{Name: "my-quota", Guid: "my-quota-guid"},
+	}
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+
+	ui := callUpdateQuota([]string{"-s", "many", "my-quota"}, reqFactory, quotaRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "Invalid services limit")
+	assert.Equal(t, quotaRepo.UpdatedQuota.Guid, "")
+}
+
+func callUpdateQuota(args []string, reqFactory *testhelpers.FakeReqFactory, quotaRepo *testhelpers.FakeQuotaRepository) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("update-quota", args)
+	cmd := NewUpdateQuota(ui, quotaRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
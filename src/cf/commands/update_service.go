@@ -0,0 +1,137 @@
+package commands
+
+import (
+	"cf"
+	"cf/api"
+	"cf/configuration"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+	"strings"
+)
+
+type UpdateService struct {
+	ui                 term.UI
+	serviceRepo        api.ServiceRepository
+	waiter             serviceOperationWaiter
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewUpdateService(ui term.UI, config *configuration.Configuration, serviceRepo api.ServiceRepository) (cmd *UpdateService) {
+	cmd = new(UpdateService)
+	cmd.ui = ui
+	cmd.serviceRepo = serviceRepo
+	cmd.waiter = newServiceOperationWaiter(ui, config, serviceRepo)
+	return
+}
+
+func (cmd *UpdateService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 || (c.String("p") == "" && c.String("c") == "") {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "update-service")
+		return
+	}
+
+	cmd.serviceInstanceReq = reqFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return
+}
+
+func (cmd *UpdateService) Run(c *cli.Context) {
+	instance := cmd.serviceInstanceReq.GetServiceInstance()
+	planName := c.String("p")
+
+	wait, err := serviceWaitOption(c)
+	if err != nil {
+		cmd.ui.Failed("Error reading service options", err)
+		return
+	}
+
+	params, err := parseServiceParameters(c.String("c"))
+	if err != nil {
+		cmd.ui.Failed("Error reading service configuration", err)
+		return
+	}
+
+	if instance.IsUserProvided() {
+		cmd.ui.Failed("Error updating service", errors.New("Plans and configuration cannot be changed for user-provided services"))
+		return
+	}
+
+	// Without a new plan only the configuration is sent.
+	plan := cf.ServicePlan{}
+	if planName != "" {
+		plan, err = cmd.findPlanOfSameOffering(instance, planName)
+		if err != nil {
+			cmd.ui.Failed("Error finding plan", err)
+			return
+		}
+
+		if plan.Guid == instance.ServicePlan.Guid {
+			if params == nil {
+				cmd.ui.Say("Service %s already uses plan %s", term.Cyan(instance.Name), term.Cyan(plan.Name))
+				cmd.ui.Ok()
+				return
+			}
+			plan = cf.ServicePlan{}
+		}
+	}
+
+	failureMessage := fmt.Sprintf("Could not update service %s", instance.Name)
+	if plan.Guid != "" {
+		cmd.ui.Say("Updating service %s to plan %s...", term.Cyan(instance.Name), term.Cyan(plan.Name))
+		failureMessage = fmt.Sprintf("Could not change the plan of service %s to %s", instance.Name, plan.Name)
+	} else {
+		cmd.ui.Say("Updating service %s...", term.Cyan(instance.Name))
+	}
+
+	err = cmd.serviceRepo.UpdateServiceInstance(instance, plan, params)
+	if err != nil {
+		cmd.ui.Failed(failureMessage, err)
+		return
+	}
+
+	err = cmd.waiter.Follow(instance, "update", wait)
+	if err != nil {
+		cmd.ui.Failed("Error updating service", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
+
+// A service instance can only move between the plans of its own offering.
+func (cmd *UpdateService) findPlanOfSameOffering(instance cf.ServiceInstance, planName string) (plan cf.ServicePlan, err error) {
+	offerings, err := cmd.serviceRepo.GetServiceOfferings()
+	if err != nil {
+		return
+	}
+
+	offeringGuid := instance.ServicePlan.ServiceOffering.Guid
+	for _, offering := range offerings {
+		if offering.Guid != offeringGuid {
+			continue
+		}
+
+		plan, err = findPlan(offering.Plans, planName)
+		if err != nil {
+			planNames := []string{}
+			for _, p := range offering.Plans {
+				planNames = append(planNames, p.Name)
+			}
+			err = errors.New(fmt.Sprintf("Plan %s is not a plan of service %s. Available plans: %s",
+				planName, offering.Label, strings.Join(planNames, ", ")))
+		}
+		return
+	}
+
+	err = errors.New(fmt.Sprintf("Could not find the offering of service %s", instance.Name))
+	return
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"github.com/codegangsta/cli"
+)
+
+type UpdateServiceBroker struct {
+	ui         term.UI
+	brokerRepo api.ServiceBrokerRepository
+}
+
+func NewUpdateServiceBroker(ui term.UI, brokerRepo api.ServiceBrokerRepository) (cmd *UpdateServiceBroker) {
+	cmd = new(UpdateServiceBroker)
+	cmd.ui = ui
+	cmd.brokerRepo = brokerRepo
+	return
+}
+
+func (cmd *UpdateServiceBroker) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 4 {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "update-service-broker")
+		return
+	}
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *UpdateServiceBroker) Run(c *cli.Context) {
+	args := c.Args()
+
+	broker, err := cmd.brokerRepo.FindByName(args[0])
+	if err != nil {
+		cmd.ui.Failed("Error finding service broker", err)
+		return
+	}
+
+	broker.Username = args[1]
+	broker.Password = args[2]
+	broker.Url = args[3]
+
+	cmd.ui.Say("Updating service broker %s...", term.Cyan(broker.Name))
+
+	err = cmd.brokerRepo.Update(broker)
+	if err != nil {
+		cmd.ui.Failed("Error updating service broker", err)
+		return
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,67 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestUpdateServiceBrokerFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{}
+
+	ui := callUpdateServiceBroker([]string{"my-broker"}, reqFactory, brokerRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callUpdateServiceBroker([]string{"my-broker", "user", "pass", "http://broker.example.com"}, reqFactory, brokerRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestUpdateServiceBrokerRequirements(t *testing.T) {
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false}
+	callUpdateServiceBroker([]string{"my-broker", "user", "pass", "http://broker.example.com"}, reqFactory, brokerRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+}
+
+func TestUpdateServiceBroker(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{
+		FindByNameServiceBroker: cf.ServiceBroker{Name: "my-broker", Guid: "my-broker-guid"},
+	}
+
+	ui := callUpdateServiceBroker([]string{"my-broker", "user", "pass", "http://broker.example.com"}, reqFactory, brokerRepo)
+
+	assert.Equal(t, brokerRepo.FindByNameName, "my-broker")
+	assert.Contains(t, ui.Outputs[0], "Updating service broker")
+	assert.Contains(t, ui.Outputs[0], "my-broker")
+	assert.Equal(t, brokerRepo.UpdatedServiceBroker, cf.ServiceBroker{
+		Name:     "my-broker",
+		Guid:     "my-broker-guid",
+		Username: "user",
+		Password: "pass",
+		Url:      "http://broker.example.com",
+	})
+	assert.Contains(t, ui.Outputs[1], "OK")
+}
+
+func TestUpdateServiceBrokerWhenNotFound(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true}
+	brokerRepo := &testhelpers.FakeServiceBrokerRepo{FindByNameNotFound: true}
+
+	ui := callUpdateServiceBroker([]string{"my-broker", "user", "pass", "http://broker.example.com"}, reqFactory, brokerRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Equal(t, brokerRepo.UpdatedServiceBroker, cf.ServiceBroker{})
+}
+
+func callUpdateServiceBroker(args []string, reqFactory *testhelpers.FakeReqFactory, brokerRepo *testhelpers.FakeServiceBrokerRepo) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("update-service-broker", args)
+	cmd := NewUpdateServiceBroker(ui, brokerRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,181 @@
+package commands_test
+
+import (
+	"cf"
+	. "cf/commands"
+	"cf/configuration"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+var cleardbOffering = cf.ServiceOffering{
+	Guid:  "cleardb-guid",
+	Label: "cleardb",
+	Plans: []cf.ServicePlan{
+		{Name: "spark", Guid: "spark-guid"},
+		{Name: "boost", Guid: "boost-guid"},
+	},
+}
+
+var elephantsqlOffering = cf.ServiceOffering{
+	Guid:  "elephantsql-guid",
+	Label: "elephantsql",
+	Plans: []cf.ServicePlan{
+		{Name: "panda", Guid: "panda-guid"},
+	},
+}
+
+var cleardbInstance = cf.ServiceInstance{
+	Name: "my-db",
+	Guid: "my-db-guid",
+	ServicePlan: cf.ServicePlan{
+		Name:            "spark",
+		Guid:            "spark-guid",
+		ServiceOffering: cf.ServiceOffering{Guid: "cleardb-guid", Label: "cleardb"},
+	},
+}
+
+func TestUpdateServiceFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callUpdateService([]string{}, reqFactory, serviceRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callUpdateService([]string{"my-db"}, reqFactory, serviceRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callUpdateService([]string{"-p", "boost", "my-db"}, reqFactory, serviceRepo)
+	assert.False(t, ui.FailedWithUsage)
+
+	ui = callUpdateService([]string{"-c", `{"nodes":5}`, "my-db"}, reqFactory, serviceRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestUpdateServiceRequirements(t *testing.T) {
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: false}
+	callUpdateService([]string{"-p", "boost", "my-db"}, reqFactory, serviceRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	callUpdateService([]string{"-p", "boost", "my-db"}, reqFactory, serviceRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+	assert.Equal(t, reqFactory.ServiceInstanceName, "my-db")
+}
+
+func TestUpdateService(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: cleardbInstance}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceOfferings: []cf.ServiceOffering{elephantsqlOffering, cleardbOffering},
+	}
+
+	ui := callUpdateService([]string{"-p", "boost", "my-db"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Updating service")
+	assert.Contains(t, ui.Outputs[0], "my-db")
+	assert.Contains(t, ui.Outputs[0], "boost")
+	assert.Contains(t, ui.Outputs[1], "OK")
+
+	assert.Equal(t, serviceRepo.UpdateServiceInstanceInstance, cleardbInstance)
+	assert.Equal(t, serviceRepo.UpdateServiceInstancePlan.Guid, "boost-guid")
+}
+
+func TestUpdateServiceWithPlanOfAnotherOffering(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: cleardbInstance}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceOfferings: []cf.ServiceOffering{elephantsqlOffering, cleardbOffering},
+	}
+
+	ui := callUpdateService([]string{"-p", "panda", "my-db"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "Plan panda is not a plan of service cleardb")
+	assert.Contains(t, ui.Outputs[2], "spark, boost")
+	assert.Equal(t, serviceRepo.UpdateServiceInstancePlan, cf.ServicePlan{})
+}
+
+func TestUpdateServiceConfigurationOnly(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: cleardbInstance}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceOfferings: []cf.ServiceOffering{cleardbOffering},
+	}
+
+	ui := callUpdateService([]string{"-p", "spark", "-c", `{"nodes":5}`, "my-db"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "Updating service")
+	assert.NotContains(t, ui.Outputs[0], "plan")
+	assert.Contains(t, ui.Outputs[1], "OK")
+
+	assert.Equal(t, serviceRepo.UpdateServiceInstancePlan, cf.ServicePlan{})
+	assert.Equal(t, serviceRepo.UpdateServiceInstanceParams, map[string]interface{}{"nodes": float64(5)})
+}
+
+func TestUpdateServiceWithCurrentPlan(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: cleardbInstance}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceOfferings: []cf.ServiceOffering{cleardbOffering},
+	}
+
+	ui := callUpdateService([]string{"-p", "spark", "my-db"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "already uses plan")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Equal(t, serviceRepo.UpdateServiceInstancePlan, cf.ServicePlan{})
+}
+
+func TestUpdateServiceWhenServiceIsUserProvided(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{
+		LoginSuccess:    true,
+		SpaceSuccess:    true,
+		ServiceInstance: cf.ServiceInstance{Name: "my-creds"},
+	}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callUpdateService([]string{"-p", "boost", "my-creds"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "user-provided")
+}
+
+func TestUpdateServiceWhenControllerRejectsPlanChange(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: cleardbInstance}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceOfferings:         []cf.ServiceOffering{cleardbOffering},
+		UpdateServiceInstanceErr: true,
+	}
+
+	ui := callUpdateService([]string{"-p", "boost", "my-db"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[1], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "Could not change the plan of service my-db to boost")
+	assert.Contains(t, ui.Outputs[3], "The service does not support changing plans.")
+}
+
+func TestUpdateServiceTimesOut(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true, ServiceInstance: cleardbInstance}
+	serviceRepo := &testhelpers.FakeServiceRepo{
+		ServiceOfferings: []cf.ServiceOffering{cleardbOffering},
+		GetServiceInstanceResponses: []cf.ServiceInstance{
+			{LastOperation: cf.LastOperation{Type: "update", State: "in progress"}},
+		},
+	}
+
+	ui := callUpdateService([]string{"-p", "boost", "my-db"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[1], "update in progress")
+	assert.Contains(t, ui.Outputs[2], "FAILED")
+	assert.Contains(t, ui.Outputs[4], "Timed out after 0 seconds")
+	assert.Contains(t, ui.Outputs[4], "cf service my-db")
+}
+
+func callUpdateService(args []string, reqFactory *testhelpers.FakeReqFactory, serviceRepo *testhelpers.FakeServiceRepo) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("update-service", args)
+	config := &configuration.Configuration{ServiceOperationTimeout: 0}
+	cmd := NewUpdateService(ui, config, serviceRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"cf/api"
+	"cf/requirements"
+	term "cf/terminal"
+	"errors"
+	"fmt"
+	"github.com/codegangsta/cli"
+)
+
+type UpdateUserProvidedService struct {
+	ui                 term.UI
+	serviceRepo        api.ServiceRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewUpdateUserProvidedService(ui term.UI, serviceRepo api.ServiceRepository) (cmd *UpdateUserProvidedService) {
+	cmd = new(UpdateUserProvidedService)
+	cmd.ui = ui
+	cmd.serviceRepo = serviceRepo
+	return
+}
+
+func (cmd *UpdateUserProvidedService) GetRequirements(reqFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 || (c.String("p") == "" && !flagGiven(c, "l") && !flagGiven(c, "r")) {
+		err = errors.New("Incorrect Usage")
+		cmd.ui.FailWithUsage(c, "update-user-provided-service")
+		return
+	}
+
+	cmd.serviceInstanceReq = reqFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		reqFactory.NewLoginRequirement(),
+		reqFactory.NewSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return
+}
+
+func (cmd *UpdateUserProvidedService) Run(c *cli.Context) {
+	instance := cmd.serviceInstanceReq.GetServiceInstance()
+
+	if !instance.IsUserProvided() {
+		err := errors.New(fmt.Sprintf("Service %s is not user-provided, use 'cf update-service' instead", instance.Name))
+		cmd.ui.Failed("Error updating service", err)
+		return
+	}
+
+	update := api.UserProvidedServiceUpdate{
+		SyslogDrainUrl:  urlFlagValue(c, "l"),
+		RouteServiceUrl: urlFlagValue(c, "r"),
+	}
+
+	if c.String("p") != "" {
+		var err error
+		update.Credentials, err = parseUserProvidedCredentials(c.String("p"))
+		if err != nil {
+			cmd.ui.Failed("Error reading credentials", err)
+			return
+		}
+	}
+
+	cmd.ui.Say("Updating user provided service %s...", term.Cyan(instance.Name))
+
+	err := cmd.serviceRepo.UpdateUserProvidedServiceInstance(instance, update)
+	if err != nil {
+		cmd.ui.Failed("Error updating user provided service instance", err)
+		return
+	}
+
+	cmd.ui.Ok()
+
+	if len(instance.ApplicationNames) > 0 {
+		cmd.ui.Say("TIP: Restart the bound apps to pick up the new credentials")
+	}
+}
+
+// A url flag that is not given leaves the url as it is, an empty one
+// removes it.
+func urlFlagValue(c *cli.Context, name string) (url *string) {
+	if flagGiven(c, name) {
+		value := c.String(name)
+		url = &value
+	}
+	return
+}
@@ -0,0 +1,110 @@
+package commands_test
+
+import (
+	"cf"
+	"cf/api"
+	. "cf/commands"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestUpdateUserProvidedServiceFailsWithUsage(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callUpdateUserProvidedService([]string{}, reqFactory, serviceRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callUpdateUserProvidedService([]string{"my-creds"}, reqFactory, serviceRepo)
+	assert.True(t, ui.FailedWithUsage)
+
+	ui = callUpdateUserProvidedService([]string{"-l", "syslog://logs.example.com", "my-creds"}, reqFactory, serviceRepo)
+	assert.False(t, ui.FailedWithUsage)
+}
+
+func TestUpdateUserProvidedServiceRequirements(t *testing.T) {
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	reqFactory := &testhelpers.FakeReqFactory{LoginSuccess: false, SpaceSuccess: true}
+	callUpdateUserProvidedService([]string{"-p", `{"a":"b"}`, "my-creds"}, reqFactory, serviceRepo)
+	assert.False(t, testhelpers.CommandDidPassRequirements)
+
+	reqFactory = &testhelpers.FakeReqFactory{LoginSuccess: true, SpaceSuccess: true}
+	callUpdateUserProvidedService([]string{"-p", `{"a":"b"}`, "my-creds"}, reqFactory, serviceRepo)
+	assert.True(t, testhelpers.CommandDidPassRequirements)
+	assert.Equal(t, reqFactory.ServiceInstanceName, "my-creds")
+}
+
+func TestUpdateUserProvidedService(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{
+		LoginSuccess: true,
+		SpaceSuccess: true,
+		ServiceInstance: cf.ServiceInstance{
+			Name:             "my-creds",
+			Guid:             "my-creds-guid",
+			ApplicationNames: []string{"my-app"},
+		},
+	}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callUpdateUserProvidedService(
+		[]string{"-p", `{"db":{"password":"rotated"}}`, "-r", "https://proxy.example.com", "my-creds"},
+		reqFactory, serviceRepo,
+	)
+
+	assert.Contains(t, ui.Outputs[0], "Updating user provided service")
+	assert.Contains(t, ui.Outputs[0], "my-creds")
+	assert.Contains(t, ui.Outputs[1], "OK")
+	assert.Contains(t, ui.Outputs[2], "TIP")
+
+	update := serviceRepo.UpdateUserProvidedServiceInstanceUpdate
+	assert.Equal(t, serviceRepo.UpdateUserProvidedServiceInstanceInstance.Guid, "my-creds-guid")
+	assert.Equal(t, update.Credentials, map[string]interface{}{"db": map[string]interface{}{"password": "rotated"}})
+	assert.Nil(t, update.SyslogDrainUrl)
+	assert.Equal(t, *update.RouteServiceUrl, "https://proxy.example.com")
+}
+
+func TestUpdateUserProvidedServiceRemovesUrls(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{
+		LoginSuccess:    true,
+		SpaceSuccess:    true,
+		ServiceInstance: cf.ServiceInstance{Name: "my-creds", Guid: "my-creds-guid"},
+	}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callUpdateUserProvidedService([]string{"-l", "", "my-creds"}, reqFactory, serviceRepo)
+
+	assert.False(t, ui.FailedWithUsage)
+	update := serviceRepo.UpdateUserProvidedServiceInstanceUpdate
+	assert.Equal(t, *update.SyslogDrainUrl, "")
+	assert.Nil(t, update.RouteServiceUrl)
+	assert.Nil(t, update.Credentials)
+}
+
+func TestUpdateUserProvidedServiceWhenServiceIsNotUserProvided(t *testing.T) {
+	reqFactory := &testhelpers.FakeReqFactory{
+		LoginSuccess: true,
+		SpaceSuccess: true,
+		ServiceInstance: cf.ServiceInstance{
+			Name:        "my-db",
+			ServicePlan: cf.ServicePlan{Name: "spark", Guid: "spark-guid"},
+		},
+	}
+	serviceRepo := &testhelpers.FakeServiceRepo{}
+
+	ui := callUpdateUserProvidedService([]string{"-p", `{"a":"b"}`, "my-db"}, reqFactory, serviceRepo)
+
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[2], "not user-provided")
+	assert.Equal(t, serviceRepo.UpdateUserProvidedServiceInstanceInstance, cf.ServiceInstance{})
+	assert.Equal(t, serviceRepo.UpdateUserProvidedServiceInstanceUpdate, api.UserProvidedServiceUpdate{})
+}
+
+func callUpdateUserProvidedService(args []string, reqFactory *testhelpers.FakeReqFactory, serviceRepo *testhelpers.FakeServiceRepo) (ui *testhelpers.FakeUI) {
+	ui = new(testhelpers.FakeUI)
+	ctxt := testhelpers.NewContext("update-user-provided-service", args)
+	cmd := NewUpdateUserProvidedService(ui, serviceRepo)
+	testhelpers.RunCommand(cmd, ctxt, reqFactory)
+	return
+}
@@ -14,7 +14,9 @@ type Configuration struct {
 	RefreshToken            string
 	Organization            cf.Organization
 	Space                   cf.Space
-	ApplicationStartTimeout time.Duration // will be used as seconds
+	ApplicationStartTimeout time.Duration     // will be used as seconds
+	ServiceOperationTimeout time.Duration     // will be used as seconds
+	DefaultDomains          map[string]string // domain names keyed by space guid
 }
 
 func (c Configuration) UserEmail() (email string) {
@@ -50,3 +52,14 @@ func (c Configuration) HasOrganization() bool {
 func (c Configuration) HasSpace() bool {
 	return c.Space.Guid != "" && c.Space.Name != ""
 }
+
+func (c Configuration) DefaultDomainForSpace(space cf.Space) string {
+	return c.DefaultDomains[space.Guid]
+}
+
+func (c *Configuration) SetDefaultDomainForSpace(space cf.Space, domainName string) {
+	if c.DefaultDomains == nil {
+		c.DefaultDomains = map[string]string{}
+	}
+	c.DefaultDomains[space.Guid] = domainName
+}
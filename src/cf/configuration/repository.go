@@ -11,6 +11,8 @@ import (
 const (
 	filePermissions = 0644
 	dirPermissions  = 0700
+
+	defaultServiceOperationTimeout = 300 // seconds
 )
 
 var singleton *Configuration
@@ -106,6 +108,7 @@ func defaultConfig() (c *Configuration) {
 	c.ApiVersion = "2"
 	c.AuthorizationEndpoint = "https://login.run.pivotal.io"
 	c.ApplicationStartTimeout = 30 // seconds
+	c.ServiceOperationTimeout = defaultServiceOperationTimeout
 
 	return
 }
@@ -128,6 +131,11 @@ func load() (c *Configuration, parseError error) {
 
 	parseError = json.Unmarshal(data, c)
 
+	// Config files written before the timeout existed leave it at zero.
+	if c.ServiceOperationTimeout == 0 {
+		c.ServiceOperationTimeout = defaultServiceOperationTimeout
+	}
+
 	return
 }
 
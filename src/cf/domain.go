@@ -12,8 +12,28 @@ const (
 )
 
 type Organization struct {
-	Name string
-	Guid string
+	Name            string
+	Guid            string
+	Spaces          []Space
+	Domains         []Domain
+	QuotaDefinition QuotaDefinition
+	AppCount        int
+	ServiceCount    int
+}
+
+type QuotaDefinition struct {
+	Name                    string
+	Guid                    string
+	MemoryLimit             uint64 // in Megabytes
+	TotalServices           int
+	TotalRoutes             int
+	NonBasicServicesAllowed bool
+}
+
+type QuotaUsage struct {
+	Memory   uint64 // in Megabytes
+	Services int
+	Routes   int
 }
 
 type Space struct {
@@ -21,6 +41,7 @@ type Space struct {
 	Guid             string
 	Applications     []Application
 	ServiceInstances []ServiceInstance
+	Domains          []Domain
 }
 
 type Application struct {
@@ -52,14 +73,18 @@ func (app Application) Health() string {
 }
 
 type Domain struct {
-	Name string
-	Guid string
+	Name               string
+	Guid               string
+	OwningOrganization Organization
+	Shared             bool
 }
 
 type Route struct {
-	Host   string
-	Guid   string
-	Domain Domain
+	Host     string
+	Guid     string
+	Domain   Domain
+	Space    Space
+	AppNames []string
 }
 
 func (r Route) URL() string {
@@ -79,9 +104,32 @@ type ApplicationInstance struct {
 type ServicePlan struct {
 	Name            string
 	Guid            string
+	Description     string
+	Free            bool
+	Public          bool // visible to every org, not only those given access
+	Costs           []ServicePlanCost
 	ServiceOffering ServiceOffering
 }
 
+type ServicePlanVisibility struct {
+	Guid             string
+	ServicePlanGuid  string
+	OrganizationGuid string
+}
+
+type ServicePlanCost struct {
+	Amount map[string]float64 // keyed by currency, e.g. "usd"
+	Unit   string
+}
+
+type ServiceBroker struct {
+	Name     string
+	Guid     string
+	Url      string
+	Username string
+	Password string
+}
+
 type ServiceOffering struct {
 	Guid        string
 	Label       string
@@ -97,10 +145,67 @@ type ServiceInstance struct {
 	ServiceBindings  []ServiceBinding
 	ServicePlan      ServicePlan
 	ApplicationNames []string
+	DashboardUrl     string
+	LastOperation    LastOperation
+
+	// Only user-provided services carry these, brokers hand out their own.
+	Credentials     map[string]interface{}
+	SyslogDrainUrl  string
+	RouteServiceUrl string
+}
+
+func (inst ServiceInstance) IsUserProvided() bool {
+	return inst.ServicePlan.Guid == ""
+}
+
+type LastOperation struct {
+	Type        string
+	State       string
+	Description string
+}
+
+const (
+	OperationInProgress = "in progress"
+	OperationSucceeded  = "succeeded"
+	OperationFailed     = "failed"
+)
+
+func (op LastOperation) InProgress() bool {
+	return op.State == OperationInProgress
+}
+
+func (op LastOperation) Failed() bool {
+	return op.State == OperationFailed
+}
+
+type ServiceKey struct {
+	Name                string
+	Guid                string
+	ServiceInstanceGuid string
+	Credentials         map[string]interface{}
 }
 
 type ServiceBinding struct {
-	Url     string
-	Guid    string
-	AppGuid string
+	Url      string
+	Guid     string
+	AppGuid  string
+	AppName  string
+	AppState string
+}
+
+type User struct {
+	Username string
+	Guid     string
 }
+
+const (
+	OrgManager     = "OrgManager"
+	BillingManager = "BillingManager"
+	OrgAuditor     = "OrgAuditor"
+	SpaceManager   = "SpaceManager"
+	SpaceDeveloper = "SpaceDeveloper"
+	SpaceAuditor   = "SpaceAuditor"
+)
+
+var OrgRoles = []string{OrgManager, BillingManager, OrgAuditor}
+var SpaceRoles = []string{SpaceManager, SpaceDeveloper, SpaceAuditor}
@@ -0,0 +1,57 @@
+package formatters
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	KILOBYTE = 1024
+	MEGABYTE = 1024 * KILOBYTE
+	GIGABYTE = 1024 * MEGABYTE
+)
+
+func ByteSize(bytes int64) string {
+	unit := ""
+	value := float64(bytes)
+
+	switch {
+	case bytes >= GIGABYTE:
+		unit = "G"
+		value = value / GIGABYTE
+	case bytes >= MEGABYTE:
+		unit = "M"
+		value = value / MEGABYTE
+	case bytes >= KILOBYTE:
+		unit = "K"
+		value = value / KILOBYTE
+	default:
+		return fmt.Sprintf("%dB", bytes)
+	}
+
+	return fmt.Sprintf("%.1f%s", value, unit)
+}
+
+func ToMegabytes(s string) (megabytes uint64, err error) {
+	value := strings.ToUpper(strings.TrimSpace(s))
+	multiplier := uint64(1)
+
+	switch {
+	case strings.HasSuffix(value, "G"):
+		multiplier = 1024
+		value = value[:len(value)-1]
+	case strings.HasSuffix(value, "M"):
+		value = value[:len(value)-1]
+	}
+
+	megabytes, err = strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("Invalid memory size %s. Use a number of megabytes, optionally followed by M or G.", s))
+		return
+	}
+
+	megabytes = megabytes * multiplier
+	return
+}
@@ -0,0 +1,34 @@
+package formatters
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestByteSize(t *testing.T) {
+	assert.Equal(t, ByteSize(0), "0B")
+	assert.Equal(t, ByteSize(512), "512B")
+	assert.Equal(t, ByteSize(2*KILOBYTE), "2.0K")
+	assert.Equal(t, ByteSize(1536*KILOBYTE), "1.5M")
+	assert.Equal(t, ByteSize(3*GIGABYTE), "3.0G")
+}
+
+func TestToMegabytes(t *testing.T) {
+	megabytes, err := ToMegabytes("512")
+	assert.NoError(t, err)
+	assert.Equal(t, megabytes, uint64(512))
+
+	megabytes, err = ToMegabytes("256M")
+	assert.NoError(t, err)
+	assert.Equal(t, megabytes, uint64(256))
+
+	megabytes, err = ToMegabytes("10g")
+	assert.NoError(t, err)
+	assert.Equal(t, megabytes, uint64(10240))
+
+	_, err = ToMegabytes("lots")
+	assert.Error(t, err)
+
+	_, err = ToMegabytes("-1G")
+	assert.Error(t, err)
+}
@@ -0,0 +1,172 @@
+package cf
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const ManifestFileName = "manifest.yml"
+
+type Manifest struct {
+	Applications []ManifestApplication
+}
+
+// ManifestApplication holds the scalar properties of one application
+// in the manifest, such as "name", "host" or "no-route".
+type ManifestApplication map[string]string
+
+func (app ManifestApplication) Name() string {
+	return app["name"]
+}
+
+func (app ManifestApplication) Bool(key string) bool {
+	value := strings.ToLower(app[key])
+	return value == "true" || value == "yes"
+}
+
+// Application returns the manifest entry for the named app. When no name
+// is given, a manifest describing a single app returns that app.
+func (m Manifest) Application(name string) (app ManifestApplication) {
+	if name == "" && len(m.Applications) == 1 {
+		return m.Applications[0]
+	}
+
+	for _, a := range m.Applications {
+		if a.Name() == name {
+			return a
+		}
+	}
+
+	return ManifestApplication{}
+}
+
+// LoadManifest reads the manifest in dir. A missing manifest, or a dir that
+// is really an archive, yields an empty manifest.
+func LoadManifest(dir string) (manifest Manifest, err error) {
+	fileInfo, err := os.Stat(dir)
+	if err != nil || !fileInfo.IsDir() {
+		err = nil
+		return
+	}
+
+	file, err := os.Open(filepath.Join(dir, ManifestFileName))
+	if os.IsNotExist(err) {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	return ParseManifest(file)
+}
+
+// ParseManifest reads the scalar properties of simple manifests, either
+// at the top level where they apply to every app, or in the entries of the
+// "applications" list. Anything else, such as nested blocks like "env",
+// block scalars or lines it cannot make sense of, is skipped.
+func ParseManifest(reader io.Reader) (manifest Manifest, err error) {
+	globals := ManifestApplication{}
+	var current ManifestApplication
+	inApplications := false
+	skipIndent := -1
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := stripManifestComment(scanner.Text())
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || trimmed == "---" {
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		isListItem := strings.HasPrefix(trimmed, "- ")
+
+		if skipIndent >= 0 {
+			if indent > skipIndent || (indent == skipIndent && isListItem) {
+				continue
+			}
+			skipIndent = -1
+		}
+
+		if indent == 0 && !isListItem {
+			inApplications = false
+		}
+
+		if isListItem && inApplications {
+			current = ManifestApplication{}
+			manifest.Applications = append(manifest.Applications, current)
+			trimmed = strings.TrimSpace(trimmed[2:])
+			indent += 2
+		}
+
+		colon := strings.Index(trimmed, ":")
+		if colon < 0 {
+			continue
+		}
+
+		key := strings.TrimSpace(trimmed[:colon])
+		value := unquoteManifestValue(strings.TrimSpace(trimmed[colon+1:]))
+
+		if indent == 0 && key == "applications" {
+			inApplications = true
+			continue
+		}
+
+		// Lines indented below a key continue its value.
+		skipIndent = indent
+
+		if value == "" || strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">") {
+			continue
+		}
+
+		if inApplications && current != nil {
+			current[key] = value
+		} else {
+			globals[key] = value
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return
+	}
+
+	if len(manifest.Applications) == 0 && len(globals) > 0 {
+		manifest.Applications = []ManifestApplication{ManifestApplication{}}
+	}
+
+	for _, app := range manifest.Applications {
+		for key, value := range globals {
+			if _, ok := app[key]; !ok {
+				app[key] = value
+			}
+		}
+	}
+
+	return
+}
+
+func stripManifestComment(line string) string {
+	if strings.HasPrefix(strings.TrimSpace(line), "#") {
+		return ""
+	}
+	if index := strings.Index(line, " #"); index >= 0 {
+		return line[:index]
+	}
+	return line
+}
+
+func unquoteManifestValue(value string) string {
+	if len(value) >= 2 {
+		first, last := value[0], value[len(value)-1]
+		if (first == '"' || first == '\'') && first == last {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
@@ -0,0 +1,91 @@
+package cf
+
+import (
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadManifest(t *testing.T) {
+	dir, err := os.Getwd()
+	assert.NoError(t, err)
+
+	manifest, err := LoadManifest(filepath.Clean(dir + "/../fixtures/manifest"))
+	assert.NoError(t, err)
+	assert.Equal(t, len(manifest.Applications), 2)
+
+	worker := manifest.Application("worker")
+	assert.Equal(t, worker.Name(), "worker")
+	assert.True(t, worker.Bool("no-route"))
+	assert.False(t, worker.Bool("random-route"))
+	assert.Equal(t, worker["domain"], "example.com")
+	assert.Equal(t, worker["QUEUE"], "")
+	assert.Equal(t, len(worker), 3)
+
+	web := manifest.Application("web")
+	assert.True(t, web.Bool("random-route"))
+	assert.Equal(t, web["domain"], "example.com")
+}
+
+func TestLoadManifestWithoutManifestFile(t *testing.T) {
+	dir, err := os.Getwd()
+	assert.NoError(t, err)
+
+	manifest, err := LoadManifest(filepath.Clean(dir + "/../fixtures/zip"))
+	assert.NoError(t, err)
+	assert.Equal(t, len(manifest.Applications), 0)
+
+	manifest, err = LoadManifest(filepath.Clean(dir + "/../fixtures/application.zip"))
+	assert.NoError(t, err)
+	assert.Equal(t, len(manifest.Applications), 0)
+}
+
+func TestParseManifestWithIndentedApplications(t *testing.T) {
+	manifest, err := ParseManifest(strings.NewReader(`
+applications:
+  - name: my-app   # the app
+    host: 'my-host'
+    services:
+    - my-db
+  - name: other-app
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, len(manifest.Applications), 2)
+	assert.Equal(t, manifest.Application("my-app")["host"], "my-host")
+	assert.Equal(t, manifest.Application("other-app").Name(), "other-app")
+}
+
+func TestParseManifestWithSingleApplication(t *testing.T) {
+	manifest, err := ParseManifest(strings.NewReader("name: my-app\nno-route: yes\n"))
+	assert.NoError(t, err)
+
+	app := manifest.Application("")
+	assert.Equal(t, app.Name(), "my-app")
+	assert.True(t, app.Bool("no-route"))
+
+	assert.Equal(t, len(manifest.Application("unknown-app")), 0)
+}
+
+func TestParseManifestSkipsValuesItDoesNotUnderstand(t *testing.T) {
+	manifest, err := ParseManifest(strings.NewReader(`
+applications:
+- name: my-app
+  command: |
+    bundle exec rake db:migrate &&
+    bundle exec rails s -p $PORT
+  description: a plain value
+    spread over two lines
+  env: {RAILS_ENV: production}
+  no-route: true
+- name: other-app
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, len(manifest.Applications), 2)
+
+	app := manifest.Application("my-app")
+	assert.Equal(t, app["command"], "")
+	assert.True(t, app.Bool("no-route"))
+	assert.Equal(t, manifest.Application("other-app").Name(), "other-app")
+}
@@ -14,6 +14,7 @@ type Factory interface {
 	NewServiceInstanceRequirement(name string) ServiceInstanceRequirement
 	NewLoginRequirement() Requirement
 	NewSpaceRequirement() Requirement
+	NewOrganizationRequirement() Requirement
 }
 
 type ApiRequirementFactory struct {
@@ -56,3 +57,10 @@ func (f ApiRequirementFactory) NewSpaceRequirement() Requirement {
 		f.repoLocator.GetConfig(),
 	)
 }
+
+func (f ApiRequirementFactory) NewOrganizationRequirement() Requirement {
+	return NewOrganizationRequirement(
+		f.ui,
+		f.repoLocator.GetConfig(),
+	)
+}
@@ -0,0 +1,27 @@
+package requirements
+
+import (
+	"cf/configuration"
+	"cf/terminal"
+	"errors"
+	"fmt"
+)
+
+type OrganizationRequirement struct {
+	ui     terminal.UI
+	config *configuration.Configuration
+}
+
+func NewOrganizationRequirement(ui terminal.UI, config *configuration.Configuration) OrganizationRequirement {
+	return OrganizationRequirement{ui, config}
+}
+
+func (req OrganizationRequirement) Execute() (err error) {
+	if !req.config.HasOrganization() {
+		message := fmt.Sprintf("No org targeted. Use '%s' to target an org.", terminal.Yellow("cf target -o"))
+		req.ui.Failed(message, nil)
+		err = errors.New("No org targeted")
+	}
+
+	return
+}
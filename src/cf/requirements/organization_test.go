@@ -0,0 +1,32 @@
+package requirements_test
+
+import (
+	"cf"
+	"cf/configuration"
+	. "cf/requirements"
+	"github.com/stretchr/testify/assert"
+	"testhelpers"
+	"testing"
+)
+
+func TestOrganizationRequirement(t *testing.T) {
+	ui := new(testhelpers.FakeUI)
+	config := &configuration.Configuration{
+		Organization: cf.Organization{
+			Name: "my-org",
+			Guid: "my-org-guid",
+		},
+	}
+
+	req := NewOrganizationRequirement(ui, config)
+	err := req.Execute()
+	assert.NoError(t, err)
+
+	config.Organization = cf.Organization{}
+
+	req = NewOrganizationRequirement(ui, config)
+	err = req.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, ui.Outputs[0], "FAILED")
+	assert.Contains(t, ui.Outputs[1], "No org targeted")
+}
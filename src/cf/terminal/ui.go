@@ -2,6 +2,7 @@ package terminal
 
 import (
 	"cf/configuration"
+	"cf/formatters"
 	"fmt"
 	"github.com/codegangsta/cli"
 	"os"
@@ -17,14 +18,21 @@ type UI interface {
 	AskForPassword(prompt string, args ...interface{}) (answer string)
 	Ok()
 	Failed(message string, err error)
+	FailAndExit(message string, err error)
 	FailWithUsage(ctxt *cli.Context, cmdName string)
 	ShowConfiguration(*configuration.Configuration)
 	LoadingIndication()
 	Wait(duration time.Duration)
 	DisplayTable(table [][]string, coloringFunc ColoringFunction)
+	DisplayProgress(bytesSent int64, totalBytes int64, bytesPerSecond int64)
 }
 
 type TerminalUI struct {
+	progress *plainProgress
+}
+
+func NewTerminalUI() TerminalUI {
+	return TerminalUI{progress: new(plainProgress)}
 }
 
 func (c TerminalUI) Say(message string, args ...interface{}) {
@@ -57,6 +65,11 @@ func (c TerminalUI) Failed(message string, err error) {
 	return
 }
 
+func (c TerminalUI) FailAndExit(message string, err error) {
+	c.Failed(message, err)
+	os.Exit(1)
+}
+
 func (c TerminalUI) FailWithUsage(ctxt *cli.Context, cmdName string) {
 	c.Failed("Incorrect Usage.\n", nil)
 	cli.ShowCommandHelp(ctxt, cmdName)
@@ -128,3 +141,52 @@ func DefaultColoringFunc(value string, row int, col int) string {
 
 	return Grey(value)
 }
+
+// When stdout is not a terminal, carriage returns would pile up in logs,
+// so progress is written as plain lines at most once per interval.
+const plainProgressInterval = 5 * time.Second
+
+// plainProgress remembers when the last plain progress line was written,
+// it is shared by all copies of a TerminalUI.
+type plainProgress struct {
+	lastLine time.Time
+}
+
+func (ui TerminalUI) DisplayProgress(bytesSent int64, totalBytes int64, bytesPerSecond int64) {
+	finished := bytesSent >= totalBytes
+	percent := 100
+	if totalBytes > 0 {
+		percent = int(bytesSent * 100 / totalBytes)
+	}
+
+	progress := fmt.Sprintf("%s of %s (%d%%) at %s/s",
+		formatters.ByteSize(bytesSent),
+		formatters.ByteSize(totalBytes),
+		percent,
+		formatters.ByteSize(bytesPerSecond),
+	)
+
+	if stdoutIsTerminal() {
+		fmt.Printf("\r%s\033[K", progress)
+		if finished {
+			fmt.Print("\n")
+		}
+		return
+	}
+
+	if !finished && ui.progress != nil {
+		if time.Since(ui.progress.lastLine) < plainProgressInterval {
+			return
+		}
+		ui.progress.lastLine = time.Now()
+	}
+	ui.Say("%s", progress)
+}
+
+func stdoutIsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -24,3 +24,14 @@ func TestSayWithStringWithFormat(t *testing.T) {
 
 	assert.Equal(t, "Hello World!\n", out)
 }
+
+func TestDisplayProgressWhenStdoutIsNotATerminal(t *testing.T) {
+	ui := NewTerminalUI()
+	out := testhelpers.CaptureOutput(func() {
+		ui.DisplayProgress(512, 2048, 1024)
+		ui.DisplayProgress(1024, 2048, 1024)
+		ui.DisplayProgress(2048, 2048, 1024)
+	})
+
+	assert.Equal(t, "512B of 2.0K (25%) at 1.0K/s\n2.0K of 2.0K (100%) at 1.0K/s\n", out)
+}
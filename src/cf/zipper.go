@@ -1,36 +1,183 @@
 package cf
 
 import (
+	"archive/tar"
 	"archive/zip"
 	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 )
 
 type Zipper interface {
 	Zip(dirToZip string) (zip *bytes.Buffer, err error)
+	ListFiles(dirToZip string) (files []AppFile, err error)
+}
+
+type AppFile struct {
+	Path string
+	Size int64
 }
 
 type ApplicationZipper struct{}
 
-func (zipper ApplicationZipper) Zip(dirOrZipFile string) (zipBuffer *bytes.Buffer, err error) {
-	if strings.HasSuffix(dirOrZipFile, ".zip") {
-		return readZipFile(dirOrZipFile)
+var (
+	zipSignature      = []byte("PK\x03\x04")
+	emptyZipSignature = []byte("PK\x05\x06")
+	gzipSignature     = []byte{0x1f, 0x8b}
+	tarSignature      = []byte("ustar")
+)
+
+const tarSignatureOffset = 257
+
+func (zipper ApplicationZipper) Zip(dirOrArchive string) (zipBuffer *bytes.Buffer, err error) {
+	info, err := os.Stat(dirOrArchive)
+	if err != nil {
+		return
+	}
+
+	if info.IsDir() {
+		return createZipFile(dirOrArchive)
+	}
+
+	return readArchive(dirOrArchive)
+}
+
+func (zipper ApplicationZipper) ListFiles(dirOrArchive string) (files []AppFile, err error) {
+	zipBuffer, err := zipper.Zip(dirOrArchive)
+	if err != nil {
+		return
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(zipBuffer.Bytes()), int64(zipBuffer.Len()))
+	if err != nil {
+		return
+	}
+
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		files = append(files, AppFile{Path: file.Name, Size: file.FileInfo().Size()})
+	}
+
+	return
+}
+
+// Archives are detected by their content rather than their extension.
+// Zip based formats (zip, jar, war) are uploaded unchanged, tarballs
+// are repackaged into a zip.
+func readArchive(file string) (zipBuffer *bytes.Buffer, err error) {
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return
+	}
+
+	switch {
+	case bytes.HasPrefix(contents, zipSignature) || bytes.HasPrefix(contents, emptyZipSignature):
+		zipBuffer = bytes.NewBuffer(contents)
+	case bytes.HasPrefix(contents, gzipSignature):
+		var gzipReader *gzip.Reader
+		gzipReader, err = gzip.NewReader(bytes.NewReader(contents))
+		if err != nil {
+			err = errors.New(fmt.Sprintf("Error reading gzipped archive %s: %s", file, err.Error()))
+			return
+		}
+		defer gzipReader.Close()
+		zipBuffer, err = repackageTar(gzipReader)
+	case isTar(contents):
+		zipBuffer, err = repackageTar(bytes.NewReader(contents))
+	default:
+		err = errors.New(fmt.Sprintf("%s is not a supported archive. Push a directory, or a zip, jar, war, tar or tar.gz file.", file))
+	}
+
+	return
+}
+
+func isTar(contents []byte) bool {
+	end := tarSignatureOffset + len(tarSignature)
+	return len(contents) >= end && bytes.Equal(contents[tarSignatureOffset:end], tarSignature)
+}
+
+func repackageTar(reader io.Reader) (zipBuffer *bytes.Buffer, err error) {
+	zipBuffer = new(bytes.Buffer)
+	writer := zip.NewWriter(zipBuffer)
+	tarReader := tar.NewReader(reader)
+
+	for {
+		var tarHeader *tar.Header
+		tarHeader, err = tarReader.Next()
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		if err != nil {
+			err = errors.New(fmt.Sprintf("Error reading tar archive: %s", err.Error()))
+			return
+		}
+
+		err = addTarEntryToZip(writer, tarHeader, tarReader)
+		if err != nil {
+			return
+		}
 	}
 
-	return createZipFile(dirOrZipFile)
+	err = writer.Close()
+	return
 }
 
-func readZipFile(file string) (zipBuffer *bytes.Buffer, err error) {
-	var zipBytes []byte
-	zipBytes, err = ioutil.ReadFile(file)
-	zipBuffer = bytes.NewBuffer(zipBytes)
+func addTarEntryToZip(writer *zip.Writer, tarHeader *tar.Header, tarReader *tar.Reader) (err error) {
+	fileName := strings.TrimPrefix(path.Clean(tarHeader.Name), "./")
+	if path.IsAbs(fileName) || escapesAppDir(fileName) {
+		err = errors.New(fmt.Sprintf("Tar entry %s is outside of the app directory", tarHeader.Name))
+		return
+	}
+
+	var content io.Reader
+	switch tarHeader.Typeflag {
+	case tar.TypeDir:
+		return
+	case tar.TypeReg, tar.TypeRegA:
+		content = tarReader
+	case tar.TypeSymlink:
+		if path.IsAbs(tarHeader.Linkname) || escapesAppDir(path.Join(path.Dir(fileName), tarHeader.Linkname)) {
+			err = errors.New(fmt.Sprintf("Symlink %s points outside of the app directory", fileName))
+			return
+		}
+		content = strings.NewReader(tarHeader.Linkname)
+	default:
+		err = errors.New(fmt.Sprintf("Tar entry %s is not a regular file, directory or symlink", fileName))
+		return
+	}
+
+	header, err := zip.FileInfoHeader(tarHeader.FileInfo())
+	if err != nil {
+		return
+	}
+	header.Name = fileName
+	if tarHeader.Typeflag != tar.TypeSymlink {
+		header.Method = zip.Deflate
+	}
+
+	zipFile, err := writer.CreateHeader(header)
+	if err != nil {
+		return
+	}
+
+	_, err = io.Copy(zipFile, content)
 	return
 }
 
+func escapesAppDir(relativePath string) bool {
+	return relativePath == ".." || strings.HasPrefix(relativePath, "../")
+}
+
 func createZipFile(dir string) (zipBuffer *bytes.Buffer, err error) {
 	zipBuffer = new(bytes.Buffer)
 	writer := zip.NewWriter(zipBuffer)
@@ -51,12 +198,29 @@ func createZipFile(dir string) (zipBuffer *bytes.Buffer, err error) {
 			return
 		}
 
-		zipFile, err := writer.Create(fileName)
+		header, err := zip.FileInfoHeader(f)
 		if err != nil {
 			return
 		}
+		header.Name = filepath.ToSlash(fileName)
+
+		var content []byte
+		if f.Mode()&os.ModeSymlink != 0 {
+			var target string
+			target, err = symlinkTarget(dir, fileName)
+			if err != nil {
+				return
+			}
+			content = []byte(target)
+		} else {
+			header.Method = zip.Deflate
+			content, err = ioutil.ReadFile(path)
+			if err != nil {
+				return
+			}
+		}
 
-		content, err := ioutil.ReadFile(path)
+		zipFile, err := writer.CreateHeader(header)
 		if err != nil {
 			return
 		}
@@ -79,6 +243,48 @@ func createZipFile(dir string) (zipBuffer *bytes.Buffer, err error) {
 	return
 }
 
+// Symlinks are stored as links, never copied. The link is followed only to
+// make sure it resolves inside the app directory; links pointing outside of
+// it are rejected since they would not exist once the app is staged.
+func symlinkTarget(dir string, fileName string) (target string, err error) {
+	path := filepath.Join(dir, fileName)
+
+	target, err = os.Readlink(path)
+	if err != nil {
+		return
+	}
+
+	appDir, err := filepath.Abs(dir)
+	if err != nil {
+		return
+	}
+
+	appDir, err = filepath.EvalSymlinks(appDir)
+	if err != nil {
+		return
+	}
+
+	resolvedPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("Could not resolve symlink %s: %s", fileName, err.Error()))
+		return
+	}
+
+	relativePath, err := filepath.Rel(appDir, resolvedPath)
+	if err != nil || escapesAppDir(filepath.ToSlash(relativePath)) {
+		err = errors.New(fmt.Sprintf("Symlink %s points outside of the app directory", fileName))
+		return
+	}
+
+	if filepath.IsAbs(target) {
+		linkDir := filepath.Dir(filepath.Join(appDir, fileName))
+		target, err = filepath.Rel(linkDir, resolvedPath)
+	}
+
+	target = filepath.ToSlash(target)
+	return
+}
+
 func fileShouldBeIgnored(exclusions []string, relativePath string) bool {
 	for _, exclusion := range exclusions {
 		if exclusion == relativePath {
@@ -1,10 +1,12 @@
 package cf
 
 import (
+	"archive/tar"
 	"archive/zip"
 	"bytes"
 	"github.com/stretchr/testify/assert"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
@@ -47,10 +49,184 @@ func TestZipWithDirectory(t *testing.T) {
 func TestZipWithZipFile(t *testing.T) {
 	dir, err := os.Getwd()
 	assert.NoError(t, err)
+	zipPath := filepath.Clean(dir + "/../fixtures/application.zip")
 
 	zipper := ApplicationZipper{}
-	zipFile, err := zipper.Zip(filepath.Clean(dir + "/../fixtures/application.zip"))
+	zipFile, err := zipper.Zip(zipPath)
 	assert.NoError(t, err)
 
-	assert.Equal(t, string(zipFile.Bytes()), "This is an application zip file\n")
+	expectedBytes, err := ioutil.ReadFile(zipPath)
+	assert.NoError(t, err)
+	assert.Equal(t, zipFile.Bytes(), expectedBytes)
+
+	reader := readZip(t, zipFile)
+	file := findZipEntry(reader, "app.txt")
+	assert.NotNil(t, file)
+	assert.Equal(t, readZipEntry(t, file), "This is an application zip file\n")
+}
+
+func TestZipWithWarFile(t *testing.T) {
+	dir, err := os.Getwd()
+	assert.NoError(t, err)
+	warPath := filepath.Clean(dir + "/../fixtures/application.war")
+
+	zipper := ApplicationZipper{}
+	zipFile, err := zipper.Zip(warPath)
+	assert.NoError(t, err)
+
+	expectedBytes, err := ioutil.ReadFile(warPath)
+	assert.NoError(t, err)
+	assert.Equal(t, zipFile.Bytes(), expectedBytes)
+}
+
+func TestZipWithTarballRepackagesIntoZip(t *testing.T) {
+	dir, err := os.Getwd()
+	assert.NoError(t, err)
+
+	zipper := ApplicationZipper{}
+	zipFile, err := zipper.Zip(filepath.Clean(dir + "/../fixtures/application.tar.gz"))
+	assert.NoError(t, err)
+
+	reader := readZip(t, zipFile)
+	assert.Equal(t, len(reader.File), 3)
+
+	file := findZipEntry(reader, "app.txt")
+	assert.NotNil(t, file)
+	assert.Equal(t, readZipEntry(t, file), "This is an application tarball\n")
+
+	file = findZipEntry(reader, "bin/start")
+	assert.NotNil(t, file)
+	assert.Equal(t, file.Mode().Perm(), os.FileMode(0755))
+
+	file = findZipEntry(reader, "start")
+	assert.NotNil(t, file)
+	assert.True(t, file.Mode()&os.ModeSymlink != 0)
+	assert.Equal(t, readZipEntry(t, file), "bin/start")
+}
+
+func TestRepackageTarWithEntriesOutsideAppDir(t *testing.T) {
+	for _, name := range []string{"../x", "/etc/x", "lib/../../x"} {
+		_, err := repackageTar(tarWithEntry(t, &tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644}))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "outside of the app directory")
+	}
+}
+
+func TestRepackageTarWithHardLink(t *testing.T) {
+	_, err := repackageTar(tarWithEntry(t, &tar.Header{Name: "start", Typeflag: tar.TypeLink, Linkname: "bin/start"}))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "start is not a regular file, directory or symlink")
+}
+
+func tarWithEntry(t *testing.T, header *tar.Header) io.Reader {
+	buffer := new(bytes.Buffer)
+	writer := tar.NewWriter(buffer)
+	assert.NoError(t, writer.WriteHeader(header))
+	assert.NoError(t, writer.Close())
+	return buffer
+}
+
+func TestZipWithUnsupportedFile(t *testing.T) {
+	dir, err := os.Getwd()
+	assert.NoError(t, err)
+
+	zipper := ApplicationZipper{}
+	_, err = zipper.Zip(filepath.Clean(dir + "/../fixtures/zip/foo.txt"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a supported archive")
+}
+
+func TestListFilesWithDirectory(t *testing.T) {
+	dir, err := os.Getwd()
+	assert.NoError(t, err)
+
+	zipper := ApplicationZipper{}
+	files, err := zipper.ListFiles(filepath.Clean(dir + "/../fixtures/zip/"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, files, []AppFile{
+		AppFile{Path: "foo.txt", Size: 27},
+		AppFile{Path: "subDir/bar.txt", Size: 23},
+	})
+}
+
+func TestZipWithExecutableFile(t *testing.T) {
+	dir, err := os.Getwd()
+	assert.NoError(t, err)
+	appDir := filepath.Clean(dir + "/../fixtures/symlinks/app")
+
+	zipper := ApplicationZipper{}
+	zipFile, err := zipper.Zip(appDir)
+	assert.NoError(t, err)
+
+	reader := readZip(t, zipFile)
+	file := findZipEntry(reader, "start.sh")
+	assert.NotNil(t, file)
+
+	info, err := os.Stat(appDir + "/start.sh")
+	assert.NoError(t, err)
+	assert.Equal(t, file.Mode().Perm(), info.Mode().Perm())
+	assert.Equal(t, file.Mode().Perm()&0111, os.FileMode(0111))
+	assert.Equal(t, file.Modified.Unix(), info.ModTime().Unix())
+}
+
+func TestZipWithSymlinksInsideAppDir(t *testing.T) {
+	dir, err := os.Getwd()
+	assert.NoError(t, err)
+
+	zipper := ApplicationZipper{}
+	zipFile, err := zipper.Zip(filepath.Clean(dir + "/../fixtures/symlinks/app"))
+	assert.NoError(t, err)
+
+	reader := readZip(t, zipFile)
+	assert.Equal(t, len(reader.File), 4)
+
+	file := findZipEntry(reader, "link-to-data")
+	assert.NotNil(t, file)
+	assert.True(t, file.Mode()&os.ModeSymlink != 0)
+	assert.Equal(t, readZipEntry(t, file), "lib/data.txt")
+
+	file = findZipEntry(reader, "linked-lib")
+	assert.NotNil(t, file)
+	assert.True(t, file.Mode()&os.ModeSymlink != 0)
+	assert.Equal(t, readZipEntry(t, file), "lib")
+
+	file = findZipEntry(reader, "lib/data.txt")
+	assert.NotNil(t, file)
+	assert.Equal(t, readZipEntry(t, file), "some data")
+}
+
+func TestZipWithSymlinkOutsideAppDir(t *testing.T) {
+	dir, err := os.Getwd()
+	assert.NoError(t, err)
+
+	zipper := ApplicationZipper{}
+	_, err = zipper.Zip(filepath.Clean(dir + "/../fixtures/symlinks/escaping"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "outside of the app directory")
+}
+
+func readZip(t *testing.T, zipFile *bytes.Buffer) *zip.Reader {
+	byteReader := bytes.NewReader(zipFile.Bytes())
+	reader, err := zip.NewReader(byteReader, int64(byteReader.Len()))
+	assert.NoError(t, err)
+	return reader
+}
+
+func findZipEntry(reader *zip.Reader, name string) *zip.File {
+	for _, file := range reader.File {
+		if file.Name == name {
+			return file
+		}
+	}
+	return nil
+}
+
+func readZipEntry(t *testing.T, file *zip.File) string {
+	buf := &bytes.Buffer{}
+	fReader, err := file.Open()
+	assert.NoError(t, err)
+	_, err = io.Copy(buf, fReader)
+	assert.NoError(t, err)
+	return string(buf.Bytes())
 }
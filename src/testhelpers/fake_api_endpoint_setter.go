@@ -0,0 +1,18 @@
+package testhelpers
+
+import (
+	"errors"
+)
+
+type FakeApiEndpointSetter struct {
+	Endpoint string
+	Err bool
+}
+
+func (setter *FakeApiEndpointSetter) SetApiEndpoint(endpoint string) (err error) {
+	setter.Endpoint = endpoint
+	if setter.Err {
+		err = errors.New("Error setting api endpoint")
+	}
+	return
+}
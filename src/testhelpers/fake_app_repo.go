@@ -2,6 +2,7 @@ package testhelpers
 
 import (
 	"cf"
+	"cf/api"
 	"errors"
 	"bytes"
 )
@@ -9,12 +10,17 @@ import (
 type FakeApplicationRepository struct {
 	StartedApp cf.Application
 	StartAppErr bool
+	StartedApps []cf.Application
 
 	StoppedApp cf.Application
 	StopAppErr bool
+	StoppedApps []cf.Application
 
 	DeletedApp cf.Application
 
+	RenamedApp cf.Application
+	RenamedAppNewName string
+
 	FindAllApps []cf.Application
 
 	AppName      string
@@ -70,15 +76,26 @@ func (repo *FakeApplicationRepository) Delete(app cf.Application) (err error){
 }
 
 
-func (repo *FakeApplicationRepository) Upload(app cf.Application, zipBuffer *bytes.Buffer) (err error) {
+func (repo *FakeApplicationRepository) Rename(app cf.Application, newName string) (err error){
+	repo.RenamedApp = app
+	repo.RenamedAppNewName = newName
+	return
+}
+
+func (repo *FakeApplicationRepository) Upload(app cf.Application, zipBuffer *bytes.Buffer, progress api.ProgressReporter) (err error) {
 	repo.UploadedZipBuffer = zipBuffer
 	repo.UploadedApp = app
 
+	if progress != nil && zipBuffer != nil {
+		progress(int64(zipBuffer.Len()), int64(zipBuffer.Len()), int64(zipBuffer.Len()))
+	}
+
 	return
 }
 
 func (repo *FakeApplicationRepository) Start(app cf.Application) (err error){
 	repo.StartedApp = app
+	repo.StartedApps = append(repo.StartedApps, app)
 	if repo.StartAppErr {
 		err = errors.New("Error starting app.")
 	}
@@ -87,6 +104,7 @@ func (repo *FakeApplicationRepository) Start(app cf.Application) (err error){
 
 func (repo *FakeApplicationRepository) Stop(app cf.Application) (err error){
 	repo.StoppedApp = app
+	repo.StoppedApps = append(repo.StoppedApps, app)
 	if repo.StopAppErr {
 		err = errors.New("Error stopping app.")
 	}
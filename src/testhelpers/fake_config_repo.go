@@ -18,6 +18,7 @@ func (repo FakeConfigRepository) Get() (c *configuration.Configuration, err erro
 		TestConfigurationSingleton.ApiVersion = "2"
 		TestConfigurationSingleton.AuthorizationEndpoint = "https://login.run.pivotal.io"
 		TestConfigurationSingleton.ApplicationStartTimeout = 30 // seconds
+		TestConfigurationSingleton.ServiceOperationTimeout = 300 // seconds
 	}
 
 	return TestConfigurationSingleton, nil
@@ -2,21 +2,85 @@ package testhelpers
 
 import (
 	"cf"
+	"errors"
 )
 
 type FakeDomainRepository struct {
 	FindAllDomains []cf.Domain
+	FindAllInCurrentOrgDomains []cf.Domain
+	FindAllInSpaceSpace cf.Space
+	FindAllInSpaceDomains []cf.Domain
 
 	FindByNameName string
 	FindByNameDomain cf.Domain
+	FindByNameErr bool
+
+	FindByNameInCurrentOrgName string
+	FindByNameInCurrentOrgDomain cf.Domain
+	FindByNameInCurrentOrgErr bool
+
+	CreateDomainDomainToCreate cf.Domain
+	CreateDomainOwningOrg cf.Organization
+
+	MapDomainDomain cf.Domain
+	MapDomainSpace cf.Space
+
+	UnmapDomainDomain cf.Domain
+	UnmapDomainSpace cf.Space
+
+	DeletedDomain cf.Domain
 }
 
 func (repo *FakeDomainRepository) FindAll() (domains []cf.Domain, err error){
 	return repo.FindAllDomains, nil
 }
 
+func (repo *FakeDomainRepository) FindAllInSpace(space cf.Space) (domains []cf.Domain, err error){
+	repo.FindAllInSpaceSpace = space
+	return repo.FindAllInSpaceDomains, nil
+}
+
+func (repo *FakeDomainRepository) FindAllInCurrentOrg() (domains []cf.Domain, err error){
+	return repo.FindAllInCurrentOrgDomains, nil
+}
+
 func (repo *FakeDomainRepository) FindByName(name string) (domain cf.Domain, err error){
 	repo.FindByNameName = name
-	return repo.FindByNameDomain, nil
+	if repo.FindByNameErr {
+		err = errors.New("Error finding domain")
+	}
+	return repo.FindByNameDomain, err
 }
 
+func (repo *FakeDomainRepository) FindByNameInCurrentOrg(name string) (domain cf.Domain, err error){
+	repo.FindByNameInCurrentOrgName = name
+	if repo.FindByNameInCurrentOrgErr {
+		err = errors.New("Error finding domain")
+	}
+	return repo.FindByNameInCurrentOrgDomain, err
+}
+
+func (repo *FakeDomainRepository) Create(domainToCreate cf.Domain, owningOrg cf.Organization) (createdDomain cf.Domain, err error){
+	repo.CreateDomainDomainToCreate = domainToCreate
+	repo.CreateDomainOwningOrg = owningOrg
+	createdDomain = domainToCreate
+	createdDomain.OwningOrganization = owningOrg
+	return
+}
+
+func (repo *FakeDomainRepository) MapDomain(domain cf.Domain, space cf.Space) (err error){
+	repo.MapDomainDomain = domain
+	repo.MapDomainSpace = space
+	return
+}
+
+func (repo *FakeDomainRepository) UnmapDomain(domain cf.Domain, space cf.Space) (err error){
+	repo.UnmapDomainDomain = domain
+	repo.UnmapDomainSpace = space
+	return
+}
+
+func (repo *FakeDomainRepository) Delete(domain cf.Domain) (err error){
+	repo.DeletedDomain = domain
+	return
+}
@@ -11,6 +11,14 @@ type FakeOrgRepository struct {
 	OrganizationName string
 	OrganizationByName cf.Organization
 	OrganizationByNameErr bool
+
+	SummaryOrganization cf.Organization
+	SummaryOrganizationErr bool
+
+	CreateName string
+	RenameOrganization cf.Organization
+	RenameNewName string
+	DeletedOrganization cf.Organization
 }
 
 func (repo FakeOrgRepository) FindAll() (orgs []cf.Organization, err error) {
@@ -25,3 +33,27 @@ func (repo *FakeOrgRepository) FindByName(name string) (org cf.Organization, err
 	return repo.OrganizationByName, err
 }
 
+
+func (repo *FakeOrgRepository) GetSummary(org cf.Organization) (orgWithSummary cf.Organization, err error) {
+	if repo.SummaryOrganizationErr {
+		err = errors.New("Error getting organization summary.")
+	}
+	return repo.SummaryOrganization, err
+}
+
+func (repo *FakeOrgRepository) Create(name string) (createdOrg cf.Organization, err error) {
+	repo.CreateName = name
+	createdOrg = cf.Organization{Name: name, Guid: name + "-guid"}
+	return
+}
+
+func (repo *FakeOrgRepository) Rename(org cf.Organization, newName string) (err error) {
+	repo.RenameOrganization = org
+	repo.RenameNewName = newName
+	return
+}
+
+func (repo *FakeOrgRepository) Delete(org cf.Organization) (err error) {
+	repo.DeletedOrganization = org
+	return
+}
@@ -0,0 +1,56 @@
+package testhelpers
+
+import (
+	"cf"
+	"errors"
+)
+
+type FakeQuotaRepository struct {
+	FindAllQuotas []cf.QuotaDefinition
+
+	FindByNameName string
+	FindByNameQuota cf.QuotaDefinition
+	FindByNameNotFound bool
+
+	CreatedQuota cf.QuotaDefinition
+	UpdatedQuota cf.QuotaDefinition
+
+	AssignQuotaToOrgOrganization cf.Organization
+	AssignQuotaToOrgQuota cf.QuotaDefinition
+
+	UsageByOrgGuid map[string]cf.QuotaUsage
+}
+
+func (repo *FakeQuotaRepository) FindAll() (quotas []cf.QuotaDefinition, err error) {
+	return repo.FindAllQuotas, nil
+}
+
+func (repo *FakeQuotaRepository) FindByName(name string) (quota cf.QuotaDefinition, err error) {
+	repo.FindByNameName = name
+	if repo.FindByNameNotFound {
+		err = errors.New("Quota not found")
+	}
+	return repo.FindByNameQuota, err
+}
+
+func (repo *FakeQuotaRepository) Create(quota cf.QuotaDefinition) (createdQuota cf.QuotaDefinition, err error) {
+	repo.CreatedQuota = quota
+	createdQuota = quota
+	createdQuota.Guid = quota.Name + "-guid"
+	return
+}
+
+func (repo *FakeQuotaRepository) Update(quota cf.QuotaDefinition) (err error) {
+	repo.UpdatedQuota = quota
+	return
+}
+
+func (repo *FakeQuotaRepository) AssignQuotaToOrg(org cf.Organization, quota cf.QuotaDefinition) (err error) {
+	repo.AssignQuotaToOrgOrganization = org
+	repo.AssignQuotaToOrgQuota = quota
+	return
+}
+
+func (repo *FakeQuotaRepository) GetUsage(org cf.Organization) (usage cf.QuotaUsage, err error) {
+	return repo.UsageByOrgGuid[org.Guid], nil
+}
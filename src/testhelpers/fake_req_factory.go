@@ -15,6 +15,7 @@ type FakeReqFactory struct {
 
 	LoginSuccess bool
 	SpaceSuccess bool
+	OrgSuccess bool
 }
 
 func (f *FakeReqFactory) NewApplicationRequirement(name string) requirements.ApplicationRequirement {
@@ -35,6 +36,10 @@ func (f *FakeReqFactory) NewSpaceRequirement() requirements.Requirement {
 	return FakeRequirement{ f, f.SpaceSuccess }
 }
 
+func (f *FakeReqFactory) NewOrganizationRequirement() requirements.Requirement {
+	return FakeRequirement{ f, f.OrgSuccess }
+}
+
 type FakeRequirement struct {
 	factory *FakeReqFactory
 	success bool
@@ -3,46 +3,86 @@ package testhelpers
 import (
 	"cf"
 	"errors"
+	"sync"
 )
 
 type FakeRouteRepository struct {
-	FindByHostHost       string
-	FindByHostErr        bool
-	FindByHostRoute      cf.Route
+	FindByHostAndDomainHost   string
+	FindByHostAndDomainDomain cf.Domain
+	FindByHostAndDomainErr    bool
+	FindByHostAndDomainRoute  cf.Route
 
 	CreatedRoute       cf.Route
 	CreatedRouteDomain cf.Domain
+	CreatedRouteSpace  cf.Space
+	CreateAttempts     []cf.Route
+	CreateErrorCodes   []int
 
 	BoundRoute cf.Route
 	BoundApp   cf.Application
 
+	UnboundRoute cf.Route
+	UnboundApp   cf.Application
+
+	DeletedRoute cf.Route
+	DeletedRoutes []cf.Route
+	DeleteErrGuid string
+	deleteMutex sync.Mutex
+
 	FindAllErr    bool
-	FindAllRoutes []cf.Route
+	FindAllInCurrentSpaceRoutes []cf.Route
+	FindAllInCurrentOrgRoutes []cf.Route
 }
 
-func (repo *FakeRouteRepository) FindAll() (routes []cf.Route, err error) {
+func (repo *FakeRouteRepository) FindAllInCurrentSpace() (routes []cf.Route, err error) {
 	if repo.FindAllErr {
 		err = errors.New("Error finding all routes")
 	}
 
-	routes = repo.FindAllRoutes
+	routes = repo.FindAllInCurrentSpaceRoutes
 	return
 }
 
-func (repo *FakeRouteRepository) FindByHost(host string) (route cf.Route, err error) {
-	repo.FindByHostHost = host
+func (repo *FakeRouteRepository) FindAllInCurrentOrg() (routes []cf.Route, err error) {
+	if repo.FindAllErr {
+		err = errors.New("Error finding all routes")
+	}
 
-	if repo.FindByHostErr {
+	routes = repo.FindAllInCurrentOrgRoutes
+	return
+}
+
+func (repo *FakeRouteRepository) FindByHostAndDomain(host string, domain cf.Domain) (route cf.Route, err error) {
+	repo.FindByHostAndDomainHost = host
+	repo.FindByHostAndDomainDomain = domain
+
+	if repo.FindByHostAndDomainErr {
 		err = errors.New("Route not found")
 	}
 
-	route = repo.FindByHostRoute
+	route = repo.FindByHostAndDomainRoute
 	return
 }
 
-func (repo *FakeRouteRepository) Create(newRoute cf.Route, domain cf.Domain) (createdRoute cf.Route, err error) {
+func (repo *FakeRouteRepository) Create(newRoute cf.Route, domain cf.Domain) (createdRoute cf.Route, errorCode int, err error) {
+	return repo.CreateInSpace(newRoute, domain, cf.Space{})
+}
+
+func (repo *FakeRouteRepository) CreateInSpace(newRoute cf.Route, domain cf.Domain, space cf.Space) (createdRoute cf.Route, errorCode int, err error) {
+	repo.CreateAttempts = append(repo.CreateAttempts, newRoute)
+
+	if len(repo.CreateErrorCodes) > 0 {
+		errorCode = repo.CreateErrorCodes[0]
+		repo.CreateErrorCodes = repo.CreateErrorCodes[1:]
+	}
+	if errorCode != 0 {
+		err = errors.New("Error creating route")
+		return
+	}
+
 	repo.CreatedRoute = newRoute
 	repo.CreatedRouteDomain = domain
+	repo.CreatedRouteSpace = space
 
 	createdRoute = cf.Route{
 		Host: newRoute.Host,
@@ -57,5 +97,22 @@ func (repo *FakeRouteRepository) Bind(route cf.Route, app cf.Application) (err e
 	return
 }
 
+func (repo *FakeRouteRepository) Unbind(route cf.Route, app cf.Application) (err error) {
+	repo.UnboundRoute = route
+	repo.UnboundApp = app
+	return
+}
+
+func (repo *FakeRouteRepository) Delete(route cf.Route) (err error) {
+	repo.deleteMutex.Lock()
+	defer repo.deleteMutex.Unlock()
 
+	if route.Guid != "" && route.Guid == repo.DeleteErrGuid {
+		err = errors.New("Error deleting route")
+		return
+	}
 
+	repo.DeletedRoute = route
+	repo.DeletedRoutes = append(repo.DeletedRoutes, route)
+	return
+}
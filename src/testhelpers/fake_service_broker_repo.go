@@ -0,0 +1,55 @@
+package testhelpers
+
+import (
+	"cf"
+	"errors"
+)
+
+type FakeServiceBrokerRepo struct {
+	FindAllBrokers []cf.ServiceBroker
+
+	FindByNameName string
+	FindByNameServiceBroker cf.ServiceBroker
+	FindByNameNotFound bool
+
+	CreatedServiceBroker cf.ServiceBroker
+	UpdatedServiceBroker cf.ServiceBroker
+
+	RenamedServiceBroker cf.ServiceBroker
+	RenamedServiceBrokerNewName string
+
+	DeletedServiceBroker cf.ServiceBroker
+}
+
+func (repo *FakeServiceBrokerRepo) FindAll() (brokers []cf.ServiceBroker, err error) {
+	return repo.FindAllBrokers, nil
+}
+
+func (repo *FakeServiceBrokerRepo) FindByName(name string) (broker cf.ServiceBroker, err error) {
+	repo.FindByNameName = name
+	if repo.FindByNameNotFound {
+		err = errors.New("Service broker not found")
+	}
+	return repo.FindByNameServiceBroker, err
+}
+
+func (repo *FakeServiceBrokerRepo) Create(broker cf.ServiceBroker) (err error) {
+	repo.CreatedServiceBroker = broker
+	return
+}
+
+func (repo *FakeServiceBrokerRepo) Update(broker cf.ServiceBroker) (err error) {
+	repo.UpdatedServiceBroker = broker
+	return
+}
+
+func (repo *FakeServiceBrokerRepo) Rename(broker cf.ServiceBroker, newName string) (err error) {
+	repo.RenamedServiceBroker = broker
+	repo.RenamedServiceBrokerNewName = newName
+	return
+}
+
+func (repo *FakeServiceBrokerRepo) Delete(broker cf.ServiceBroker) (err error) {
+	repo.DeletedServiceBroker = broker
+	return
+}
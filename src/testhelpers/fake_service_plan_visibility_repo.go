@@ -0,0 +1,29 @@
+package testhelpers
+
+import (
+	"cf"
+)
+
+type FakeServicePlanVisibilityRepo struct {
+	Visibilities []cf.ServicePlanVisibility
+
+	CreatedPlans []cf.ServicePlan
+	CreatedOrgs []cf.Organization
+
+	DeletedVisibilities []cf.ServicePlanVisibility
+}
+
+func (repo *FakeServicePlanVisibilityRepo) FindAll() (visibilities []cf.ServicePlanVisibility, err error) {
+	return repo.Visibilities, nil
+}
+
+func (repo *FakeServicePlanVisibilityRepo) Create(plan cf.ServicePlan, org cf.Organization) (err error) {
+	repo.CreatedPlans = append(repo.CreatedPlans, plan)
+	repo.CreatedOrgs = append(repo.CreatedOrgs, org)
+	return
+}
+
+func (repo *FakeServicePlanVisibilityRepo) Delete(visibility cf.ServicePlanVisibility) (err error) {
+	repo.DeletedVisibilities = append(repo.DeletedVisibilities, visibility)
+	return
+}
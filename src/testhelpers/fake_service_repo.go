@@ -2,29 +2,76 @@ package testhelpers
 
 import (
 	"cf"
+	"cf/api"
 	"errors"
 )
 
 type FakeServiceRepo struct {
 	ServiceOfferings []cf.ServiceOffering
 
+	GetServicePlansOffering cf.ServiceOffering
+	ServicePlans []cf.ServicePlan
+
+	SetServicePlanPublicPlans []cf.ServicePlan
+	SetServicePlanPublicValues []bool
+
 	CreateServiceInstanceName string
 	CreateServiceInstancePlan cf.ServicePlan
+	CreateServiceInstanceParams map[string]interface{}
+	CreateServiceInstanceLastOperation cf.LastOperation
 
 	CreateUserProvidedServiceInstanceName string
-	CreateUserProvidedServiceInstanceParameters map[string]string
+	CreateUserProvidedServiceInstanceParameters map[string]interface{}
+	CreateUserProvidedServiceInstanceSyslogDrainUrl string
+	CreateUserProvidedServiceInstanceRouteServiceUrl string
+
+	UpdateUserProvidedServiceInstanceInstance cf.ServiceInstance
+	UpdateUserProvidedServiceInstanceUpdate api.UserProvidedServiceUpdate
+
+	UpdateServiceInstanceInstance cf.ServiceInstance
+	UpdateServiceInstancePlan cf.ServicePlan
+	UpdateServiceInstanceParams map[string]interface{}
+	UpdateServiceInstanceErr bool
 
 	FindInstanceByNameName string
 	FindInstanceByNameServiceInstance cf.ServiceInstance
+	FindInstanceByNameMap map[string]cf.ServiceInstance
+
+	GetServiceInstanceGuids []string
+	GetServiceInstanceResponses []cf.ServiceInstance
+	GetServiceInstanceErrorCodes []int
 
 	BindServiceServiceInstance cf.ServiceInstance
 	BindServiceApplication cf.Application
+	BindServiceParams map[string]interface{}
 	BindServiceErrorCode int
+	BindServiceServiceInstances []cf.ServiceInstance
+	BindServiceApplications []cf.Application
+	BindServiceFailingAppGuid string
 
 	UnbindServiceServiceInstance cf.ServiceInstance
 	UnbindServiceApplication cf.Application
+	UnbindServiceServiceInstances []cf.ServiceInstance
+	UnbindServiceApplications []cf.Application
+	UnbindServiceErr bool
 
 	DeleteServiceServiceInstance cf.ServiceInstance
+
+	RenameServiceServiceInstance cf.ServiceInstance
+	RenameServiceNewName string
+
+	CreateServiceKeyInstance cf.ServiceInstance
+	CreateServiceKeyName string
+	CreateServiceKeyErrorCode int
+
+	GetServiceKeysInstance cf.ServiceInstance
+	ServiceKeys []cf.ServiceKey
+
+	FindServiceKeyInstance cf.ServiceInstance
+	FindServiceKeyName string
+	FindServiceKeyNotFound bool
+
+	DeletedServiceKey cf.ServiceKey
 }
 
 func (repo *FakeServiceRepo) GetServiceOfferings() (offerings []cf.ServiceOffering, err error) {
@@ -32,27 +79,110 @@ func (repo *FakeServiceRepo) GetServiceOfferings() (offerings []cf.ServiceOfferi
 	return
 }
 
-func (repo *FakeServiceRepo) CreateServiceInstance(name string, plan cf.ServicePlan) (err error) {
+func (repo *FakeServiceRepo) GetServicePlans(offering cf.ServiceOffering) (plans []cf.ServicePlan, err error) {
+	repo.GetServicePlansOffering = offering
+	plans = repo.ServicePlans
+	return
+}
+
+func (repo *FakeServiceRepo) SetServicePlanPublic(plan cf.ServicePlan, public bool) (err error) {
+	repo.SetServicePlanPublicPlans = append(repo.SetServicePlanPublicPlans, plan)
+	repo.SetServicePlanPublicValues = append(repo.SetServicePlanPublicValues, public)
+	return
+}
+
+func (repo *FakeServiceRepo) CreateServiceInstance(name string, plan cf.ServicePlan, params map[string]interface{}) (instance cf.ServiceInstance, err error) {
 	repo.CreateServiceInstanceName = name
 	repo.CreateServiceInstancePlan = plan
+	repo.CreateServiceInstanceParams = params
+
+	instance = cf.ServiceInstance{
+		Name: name,
+		Guid: name + "-guid",
+		ServicePlan: plan,
+		LastOperation: repo.CreateServiceInstanceLastOperation,
+	}
+	return
+}
+
+func (repo *FakeServiceRepo) CreateUserProvidedServiceInstance(instance cf.ServiceInstance) (err error) {
+	repo.CreateUserProvidedServiceInstanceName = instance.Name
+	repo.CreateUserProvidedServiceInstanceParameters = instance.Credentials
+	repo.CreateUserProvidedServiceInstanceSyslogDrainUrl = instance.SyslogDrainUrl
+	repo.CreateUserProvidedServiceInstanceRouteServiceUrl = instance.RouteServiceUrl
 	return
 }
 
-func (repo *FakeServiceRepo) CreateUserProvidedServiceInstance(name string, params map[string]string) (err error) {
-	repo.CreateUserProvidedServiceInstanceName = name
-	repo.CreateUserProvidedServiceInstanceParameters = params
+func (repo *FakeServiceRepo) UpdateUserProvidedServiceInstance(instance cf.ServiceInstance, update api.UserProvidedServiceUpdate) (err error) {
+	repo.UpdateUserProvidedServiceInstanceInstance = instance
+	repo.UpdateUserProvidedServiceInstanceUpdate = update
+	return
+}
+
+func (repo *FakeServiceRepo) UpdateServiceInstance(instance cf.ServiceInstance, plan cf.ServicePlan, params map[string]interface{}) (err error) {
+	repo.UpdateServiceInstanceInstance = instance
+	repo.UpdateServiceInstancePlan = plan
+	repo.UpdateServiceInstanceParams = params
+
+	if repo.UpdateServiceInstanceErr {
+		err = errors.New("Server error, status code: 400, error code: 60015, message: The service does not support changing plans.")
+	}
 	return
 }
 
 func (repo *FakeServiceRepo) FindInstanceByName(name string) (instance cf.ServiceInstance, err error) {
 	repo.FindInstanceByNameName = name
+
+	if repo.FindInstanceByNameMap != nil {
+		var found bool
+		instance, found = repo.FindInstanceByNameMap[name]
+		if !found {
+			err = errors.New("Service instance not found")
+		}
+		return
+	}
+
 	instance = repo.FindInstanceByNameServiceInstance
 	return
 }
 
-func (repo *FakeServiceRepo) BindService(instance cf.ServiceInstance, app cf.Application) (errorCode int, err error) {
+// GetServiceInstance hands out the queued responses in order. Once they run
+// out, instances are reported as done with their last operation.
+func (repo *FakeServiceRepo) GetServiceInstance(guid string) (instance cf.ServiceInstance, errorCode int, err error) {
+	repo.GetServiceInstanceGuids = append(repo.GetServiceInstanceGuids, guid)
+
+	if len(repo.GetServiceInstanceResponses) == 0 && len(repo.GetServiceInstanceErrorCodes) == 0 {
+		instance = cf.ServiceInstance{Guid: guid, LastOperation: cf.LastOperation{State: cf.OperationSucceeded}}
+		return
+	}
+
+	if len(repo.GetServiceInstanceErrorCodes) > 0 {
+		errorCode = repo.GetServiceInstanceErrorCodes[0]
+		repo.GetServiceInstanceErrorCodes = repo.GetServiceInstanceErrorCodes[1:]
+	}
+
+	if len(repo.GetServiceInstanceResponses) > 0 {
+		instance = repo.GetServiceInstanceResponses[0]
+		repo.GetServiceInstanceResponses = repo.GetServiceInstanceResponses[1:]
+	}
+
+	if errorCode != 0 {
+		err = errors.New("Error getting service instance")
+	}
+	return
+}
+
+func (repo *FakeServiceRepo) BindService(instance cf.ServiceInstance, app cf.Application, params map[string]interface{}) (errorCode int, err error) {
 	repo.BindServiceServiceInstance = instance
 	repo.BindServiceApplication = app
+	repo.BindServiceParams = params
+	repo.BindServiceServiceInstances = append(repo.BindServiceServiceInstances, instance)
+	repo.BindServiceApplications = append(repo.BindServiceApplications, app)
+
+	if repo.BindServiceFailingAppGuid != "" && repo.BindServiceFailingAppGuid == app.Guid {
+		err = errors.New("Error binding service")
+		return
+	}
 
 	if repo.BindServiceErrorCode != 0 {
 		err = errors.New("Error binding service")
@@ -65,6 +195,12 @@ func (repo *FakeServiceRepo) BindService(instance cf.ServiceInstance, app cf.App
 func (repo *FakeServiceRepo) UnbindService(instance cf.ServiceInstance, app cf.Application) (err error) {
 	repo.UnbindServiceServiceInstance = instance
 	repo.UnbindServiceApplication = app
+	repo.UnbindServiceServiceInstances = append(repo.UnbindServiceServiceInstances, instance)
+	repo.UnbindServiceApplications = append(repo.UnbindServiceApplications, app)
+
+	if repo.UnbindServiceErr {
+		err = errors.New("Error unbinding service")
+	}
 	return
 }
 
@@ -72,3 +208,49 @@ func (repo *FakeServiceRepo) DeleteService(instance cf.ServiceInstance) (err err
 	repo.DeleteServiceServiceInstance = instance
 	return
 }
+
+func (repo *FakeServiceRepo) RenameService(instance cf.ServiceInstance, newName string) (err error) {
+	repo.RenameServiceServiceInstance = instance
+	repo.RenameServiceNewName = newName
+	return
+}
+
+func (repo *FakeServiceRepo) CreateServiceKey(instance cf.ServiceInstance, keyName string) (errorCode int, err error) {
+	repo.CreateServiceKeyInstance = instance
+	repo.CreateServiceKeyName = keyName
+
+	if repo.CreateServiceKeyErrorCode != 0 {
+		err = errors.New("Error creating service key")
+		errorCode = repo.CreateServiceKeyErrorCode
+	}
+	return
+}
+
+func (repo *FakeServiceRepo) GetServiceKeys(instance cf.ServiceInstance) (keys []cf.ServiceKey, err error) {
+	repo.GetServiceKeysInstance = instance
+	keys = repo.ServiceKeys
+	return
+}
+
+func (repo *FakeServiceRepo) FindServiceKey(instance cf.ServiceInstance, keyName string) (key cf.ServiceKey, err error) {
+	repo.FindServiceKeyInstance = instance
+	repo.FindServiceKeyName = keyName
+
+	if repo.FindServiceKeyNotFound {
+		err = errors.New("Service key not found")
+		return
+	}
+
+	for _, k := range repo.ServiceKeys {
+		if k.Name == keyName {
+			key = k
+			return
+		}
+	}
+	return
+}
+
+func (repo *FakeServiceRepo) DeleteServiceKey(key cf.ServiceKey) (err error) {
+	repo.DeletedServiceKey = key
+	return
+}
@@ -15,6 +15,12 @@ type FakeSpaceRepository struct {
 	SpaceByNameErr bool
 
 	SummarySpace cf.Space
+	SpaceSummarySpace cf.Space
+
+	CreateSpaceName string
+	RenameSpace cf.Space
+	RenameNewName string
+	DeletedSpace cf.Space
 }
 
 func (repo FakeSpaceRepository) GetCurrentSpace() (space cf.Space) {
@@ -37,3 +43,25 @@ func (repo *FakeSpaceRepository) GetSummary() (space cf.Space, err error) {
 	space = repo.SummarySpace
 	return
 }
+
+func (repo *FakeSpaceRepository) GetSpaceSummary(space cf.Space) (spaceWithSummary cf.Space, err error) {
+	spaceWithSummary = repo.SpaceSummarySpace
+	return
+}
+
+func (repo *FakeSpaceRepository) Create(name string) (createdSpace cf.Space, err error) {
+	repo.CreateSpaceName = name
+	createdSpace = cf.Space{Name: name, Guid: name + "-guid"}
+	return
+}
+
+func (repo *FakeSpaceRepository) Rename(space cf.Space, newName string) (err error) {
+	repo.RenameSpace = space
+	repo.RenameNewName = newName
+	return
+}
+
+func (repo *FakeSpaceRepository) Delete(space cf.Space) (err error) {
+	repo.DeletedSpace = space
+	return
+}
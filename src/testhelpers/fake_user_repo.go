@@ -0,0 +1,78 @@
+package testhelpers
+
+import (
+	"cf"
+	"errors"
+)
+
+type FakeUserRepository struct {
+	FindByUsernameUsername string
+	FindByUsernameUser cf.User
+	FindByUsernameNotFound bool
+
+	FindAllInOrgByRoleOrganization cf.Organization
+	FindAllInSpaceByRoleSpace cf.Space
+	UsersByRole map[string][]cf.User
+
+	SetOrgRoleUser cf.User
+	SetOrgRoleOrganization cf.Organization
+	SetOrgRoleRole string
+
+	UnsetOrgRoleUser cf.User
+	UnsetOrgRoleOrganization cf.Organization
+	UnsetOrgRoleRole string
+
+	SetSpaceRoleUser cf.User
+	SetSpaceRoleSpace cf.Space
+	SetSpaceRoleRole string
+
+	UnsetSpaceRoleUser cf.User
+	UnsetSpaceRoleSpace cf.Space
+	UnsetSpaceRoleRole string
+}
+
+func (repo *FakeUserRepository) FindByUsername(username string) (user cf.User, err error) {
+	repo.FindByUsernameUsername = username
+	if repo.FindByUsernameNotFound {
+		err = errors.New("User not found")
+	}
+	return repo.FindByUsernameUser, err
+}
+
+func (repo *FakeUserRepository) FindAllInOrgByRole(org cf.Organization) (usersByRole map[string][]cf.User, err error) {
+	repo.FindAllInOrgByRoleOrganization = org
+	return repo.UsersByRole, nil
+}
+
+func (repo *FakeUserRepository) FindAllInSpaceByRole(space cf.Space) (usersByRole map[string][]cf.User, err error) {
+	repo.FindAllInSpaceByRoleSpace = space
+	return repo.UsersByRole, nil
+}
+
+func (repo *FakeUserRepository) SetOrgRole(user cf.User, org cf.Organization, role string) (err error) {
+	repo.SetOrgRoleUser = user
+	repo.SetOrgRoleOrganization = org
+	repo.SetOrgRoleRole = role
+	return
+}
+
+func (repo *FakeUserRepository) UnsetOrgRole(user cf.User, org cf.Organization, role string) (err error) {
+	repo.UnsetOrgRoleUser = user
+	repo.UnsetOrgRoleOrganization = org
+	repo.UnsetOrgRoleRole = role
+	return
+}
+
+func (repo *FakeUserRepository) SetSpaceRole(user cf.User, space cf.Space, role string) (err error) {
+	repo.SetSpaceRoleUser = user
+	repo.SetSpaceRoleSpace = space
+	repo.SetSpaceRoleRole = role
+	return
+}
+
+func (repo *FakeUserRepository) UnsetSpaceRole(user cf.User, space cf.Space, role string) (err error) {
+	repo.UnsetSpaceRoleUser = user
+	repo.UnsetSpaceRoleSpace = space
+	repo.UnsetSpaceRoleRole = role
+	return
+}
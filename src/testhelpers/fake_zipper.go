@@ -1,13 +1,24 @@
 package testhelpers
 
-import "bytes"
+import (
+	"bytes"
+	"cf"
+)
 
 type FakeZipper struct {
 	ZippedDir string
 	ZippedBuffer *bytes.Buffer
+
+	ListedDir string
+	ListedFiles []cf.AppFile
 }
 
 func (zipper *FakeZipper) Zip(dir string) (zipBuffer *bytes.Buffer, err error) {
 	zipper.ZippedDir = dir
 	return zipper.ZippedBuffer, nil
 }
+
+func (zipper *FakeZipper) ListFiles(dir string) (files []cf.AppFile, err error) {
+	zipper.ListedDir = dir
+	return zipper.ListedFiles, nil
+}
@@ -38,6 +38,8 @@ type FakeUI struct {
 	Prompts []string
 	Inputs  []string
 	FailedWithUsage bool
+	FailedAndExited bool
+	ProgressReports [][]int64
 }
 
 func (ui *FakeUI) Say(message string, args ...interface{}) {
@@ -73,6 +75,11 @@ func (ui *FakeUI) Failed(message string, err error) {
 	return
 }
 
+func (ui *FakeUI) FailAndExit(message string, err error) {
+	ui.FailedAndExited = true
+	ui.Failed(message, err)
+}
+
 func (ui *FakeUI) FailWithUsage(ctxt *cli.Context, cmdName string) {
 	ui.FailedWithUsage = true
 	ui.Failed("Incorrect Usage.", nil)
@@ -131,3 +138,7 @@ func (ui *FakeUI) DisplayTable(table [][]string, coloringFunc term.ColoringFunct
 		ui.Say(output)
 	}
 }
+
+func (ui *FakeUI) DisplayProgress(bytesSent int64, totalBytes int64, bytesPerSecond int64) {
+	ui.ProgressReports = append(ui.ProgressReports, []int64{bytesSent, totalBytes, bytesPerSecond})
+}